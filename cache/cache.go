@@ -0,0 +1,115 @@
+// This package is a memory capped, least recently used cache of relay responses.
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/config"
+)
+
+// the bytes accounted for an entry besides its key and value
+const entryOverhead = 64
+
+// "Stats" are the counters of a cache.
+type Stats struct {
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions"` // entries removed to stay within the memory cap
+	Entries   int    `json:"entries"`
+	Bytes     int    `json:"bytes"`
+	MaxBytes  int    `json:"maxbytes"`
+}
+
+type entry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// "size" returns the bytes accounted for the entry.
+func (e *entry) size() int {
+	return len(e.key) + len(e.value) + entryOverhead
+}
+
+// "LRU" is a cache that evicts the least recently used entries once it exceeds its memory cap.
+type LRU struct {
+	max   int
+	ll    *list.List               // the most recently used entry first
+	items map[string]*list.Element // <key><element of ll>
+	stats Stats
+	mux   sync.Mutex
+}
+
+var (
+	relays    *LRU
+	relayOnce sync.Once
+)
+
+// "Relays" is the singleton accessor of the relay response cache, capped by the cachesize flag.
+func Relays() *LRU {
+	relayOnce.Do(func() {
+		relays = New(config.GlobalConfig().CacheSize * 1024 * 1024)
+	})
+	return relays
+}
+
+// "New" returns a cache holding at most max bytes.
+func New(max int) *LRU {
+	return &LRU{max: max, ll: list.New(), items: make(map[string]*list.Element), stats: Stats{MaxBytes: max}}
+}
+
+// "Get" returns the unexpired value of the key, counting a hit or a miss.
+func (c *LRU) Get(key string) ([]byte, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	el, ok := c.items[key]
+	if ok && time.Now().After(el.Value.(*entry).expires) {
+		c.remove(el)
+		ok = false
+	}
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	c.stats.Hits++
+	return el.Value.(*entry).value, true
+}
+
+// "Set" stores the value of the key for the ttl, evicting the least recently used entries if over the memory cap.
+// Values that exceed the cap on their own are not stored.
+func (c *LRU) Set(key string, value []byte, ttl time.Duration) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	e := &entry{key: key, value: value, expires: time.Now().Add(ttl)}
+	if ttl <= 0 || e.size() > c.max {
+		return
+	}
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+	c.items[key] = c.ll.PushFront(e)
+	c.stats.Bytes += e.size()
+	for c.stats.Bytes > c.max {
+		c.remove(c.ll.Back())
+		c.stats.Evictions++
+	}
+}
+
+// "remove" deletes the element from the cache.
+func (c *LRU) remove(el *list.Element) {
+	e := c.ll.Remove(el).(*entry)
+	delete(c.items, e.key)
+	c.stats.Bytes -= e.size()
+}
+
+// "Stats" returns the counters of the cache.
+func (c *LRU) Stats() Stats {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	s := c.stats
+	s.Entries = c.ll.Len()
+	return s
+}
@@ -19,6 +19,7 @@ set -o nounset
 # AWS_DYNAMODB_ENDPOINT = AWS dynamodb endpoint (defaults to dynamodb.us-east-1.amazonaws.com)
 # AWS_DYNAMODB_TABLE = AWS dynamodb table name (defaults to dispatch-peers-staging)
 # AWS_DYNAMODB_REGION = AWS dynamodb region (defaults to us-east-1)
+# POCKET_CORE_PEER_STORE = Storage backend for registered peers: dynamo | bolt | memory (defaults to dynamo)
 # POCKET_CORE_DISPATCH_GID = The GID assigned to this dispatch instance
 
 # Service only
@@ -35,6 +36,7 @@ if [ ${POCKET_CORE_NODE_TYPE:-service} = "dispatch" ]; then
 	  --dbend ${POCKET_CORE_AWS_DYNAMODB_ENDPOINT:-dynamodb.us-east-1.amazonaws.com} \
 	  --dbtable ${POCKET_CORE_AWS_DYNAMODB_TABLE:-dispatch-peers-staging} \
 	  --dbregion ${POCKET_CORE_AWS_DYNAMODB_REGION:-us-east-1} \
+	  --peerstore ${POCKET_CORE_PEER_STORE:-dynamo} \
 	  --disip ${POCKET_CORE_DISPATCH_IP:-127.0.0.1} \
 	  --disrport ${POCKET_CORE_DISPATCH_PORT:-8081} \
 	  --gid ${POCKET_CORE_DISPATCH_GID:-GID1} \
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/crypto/keystore"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const keysUsage = `usage: pocket_core keys <command> [flags] [args]
+
+commands:
+  new                 generate a new key
+  import              import a hex encoded private key (or seed), read from -keyfile or prompted for
+  export <address>    print the hex encoded private key of the address
+  list                list the addresses of the keystore
+
+flags:
+`
+
+// the reader of non terminal input, shared so buffered lines aren't lost between prompts
+var stdin = bufio.NewReader(os.Stdin)
+
+// "keys" runs the keystore management subcommands.
+func keys(args []string) {
+	fs := flag.NewFlagSet("keys", flag.ExitOnError)
+	dd := fs.String("datadirectory", _const.DATADIR, "the data directory of the keystore")
+	passFile := fs.String("passfile", "", "the file holding the passphrase (prompts if not set)")
+	keyFile := fs.String("keyfile", "", "the file holding the hex encoded private key to import (prompts if not set)")
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, keysUsage)
+		fs.PrintDefaults()
+	}
+	if len(args) == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	cmd := args[0]
+	// flags may come before or after the positional arguments
+	var pos []string
+	for rest := args[1:]; ; {
+		fs.Parse(rest)
+		rest = fs.Args()
+		if len(rest) == 0 {
+			break
+		}
+		pos, rest = append(pos, rest[0]), rest[1:]
+	}
+	ks := keystore.New(filepath.Join(*dd, _const.KEYSTOREDIR))
+	if err := runKeys(ks, cmd, pos, *passFile, *keyFile); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
+
+// "runKeys" executes a single keystore command.
+func runKeys(ks *keystore.Keystore, cmd string, args []string, passFile, keyFile string) error {
+	switch cmd {
+	case "new":
+		pass, err := passphrase(passFile, true)
+		if err != nil {
+			return err
+		}
+		k, err := ks.Generate(pass)
+		if err != nil {
+			return err
+		}
+		fmt.Println(k.Address)
+	case "import":
+		// a private key argument would be kept in the shell history and shown in the process list
+		if len(args) != 0 {
+			return errors.New("import reads the private key from -keyfile or prompts for it, it is not an argument")
+		}
+		priv, err := secret(keyFile, "Private key: ")
+		if err != nil {
+			return err
+		}
+		pass, err := passphrase(passFile, true)
+		if err != nil {
+			return err
+		}
+		k, err := ks.Import(priv, pass)
+		if err != nil {
+			return err
+		}
+		fmt.Println(k.Address)
+	case "export":
+		if len(args) != 1 {
+			return errors.New("export expects the address of the key")
+		}
+		pass, err := passphrase(passFile, false)
+		if err != nil {
+			return err
+		}
+		priv, err := ks.Export(args[0], pass)
+		if err != nil {
+			return err
+		}
+		fmt.Println(priv)
+	case "list":
+		addrs, err := ks.List()
+		if err != nil {
+			return err
+		}
+		for _, a := range addrs {
+			fmt.Println(a)
+		}
+	default:
+		return errors.New("unknown keys command: " + cmd)
+	}
+	return nil
+}
+
+// "secret" reads the secret from the file, or prompts for it.
+func secret(file, p string) (string, error) {
+	if file != "" {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(b), "\r\n"), nil
+	}
+	return prompt(p)
+}
+
+// "passphrase" reads the passphrase from the file, or prompts for it (twice if confirm).
+func passphrase(passFile string, confirm bool) (string, error) {
+	if passFile != "" {
+		return secret(passFile, "")
+	}
+	pass, err := prompt("Passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if confirm {
+		again, err := prompt("Repeat passphrase: ")
+		if err != nil {
+			return "", err
+		}
+		if pass != again {
+			return "", errors.New("the passphrases do not match")
+		}
+	}
+	return pass, nil
+}
+
+// "prompt" reads a line from the terminal without echoing it (or from stdin if it isn't a terminal).
+func prompt(p string) (string, error) {
+	fmt.Fprint(os.Stderr, p)
+	fd := int(os.Stdin.Fd())
+	if terminal.IsTerminal(fd) {
+		b, err := terminal.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		return string(b), err
+	}
+	line, err := stdin.ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
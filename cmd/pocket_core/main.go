@@ -2,12 +2,16 @@
 package main
 
 import (
+	"os"
+
 	"github.com/pokt-network/pocket-core/config"
 	"github.com/pokt-network/pocket-core/crypto"
 	"github.com/pokt-network/pocket-core/db"
+	"github.com/pokt-network/pocket-core/health"
 	"github.com/pokt-network/pocket-core/logs"
 	"github.com/pokt-network/pocket-core/node"
 	"github.com/pokt-network/pocket-core/rpc"
+	"github.com/pokt-network/pocket-core/usage"
 )
 
 // "init" is a built in function that is automatically called before main.
@@ -18,6 +22,11 @@ func init() {
 
 // "main" is the starting function of the client.
 func main() {
+	// keystore management subcommands
+	if len(os.Args) > 1 && os.Args[1] == "keys" {
+		keys(os.Args[2:])
+		return
+	}
 	startClient()
 }
 
@@ -27,18 +36,22 @@ func startClient() {
 	config.Init()
 	// builds the proper structure on pc for core client to operate
 	config.Build()
+	// closes the log file on exit
+	node.OnExit(logs.Close)
 	// builds node structures from files
 	node.ConfigFiles()
 	// print the configuration the the cmd
 	config.Print()
 	// add peers to dispatch structure
 	node.PeerList().CopyToDP()
-	// check for hosted chains
-	node.TestChains()
+	// check the hosted chains, then keep monitoring their health
+	health.Start()
+	// load the relay usage counters and persist them periodically
+	usage.Start()
+	// restore peers from the peer store and run db refresh on peers (if dispatch node)
+	db.PeersRefresh()
 	// runs the server endpoints for client and relay api
 	rpc.StartServers()
-	// run db refresh on peers (if dispatch node)
-	db.PeersRefresh()
 	// runs a check on all service nodes periodically
 	db.CheckPeers()
 	// sends an entry message to the centralized dispatcher
@@ -32,7 +32,7 @@ func dataDir() {
 // "logsDir" builds the directory for logs.
 func logsDir() {
 	// attempts to make the logs directory
-	if err := os.MkdirAll(GlobalConfig().DD+_const.FILESEPARATOR+"logs", os.ModePerm); err != nil {
+	if err := os.MkdirAll(GlobalConfig().DD+_const.FILESEPARATOR+_const.LOGSDIR, os.ModePerm); err != nil {
 		log.Fatal(err.Error())
 	}
 }
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"log"
+	"strings"
 	"sync"
 
 	"github.com/pokt-network/pocket-core/const"
@@ -31,6 +33,26 @@ type config struct {
 	DisRPort       string `json:"DISRPort"`       // The relay port of the centralized dispatcher
 	PRefresh       int    `json:"PREFRESH"`       // The peer refresh time for the centralized dispatcher in seconds
 	RequestTimeout int    `json:"REQUESTTIMEOUT"` // The timeout for http requests
+	PeerStore      string `json:"PEERSTORE"`      // The storage backend for the dispatcher's peers (dynamo, bolt, memory)
+	DisStrategy    string `json:"DISSTRATEGY"`    // The load balancing strategy of the dispatcher
+	DisCount       int    `json:"DISCOUNT"`       // The default number of nodes served per blockchain by the dispatcher
+	SessLength     int    `json:"SESSLENGTH"`     // The length of a developer session epoch in seconds
+	SessNodes      int    `json:"SESSNODES"`      // The number of nodes within a developer session
+	SessCheck      bool   `json:"SESSCHECK"`      // Whether or not the service node only serves relays of its sessions
+	LFile          string `json:"LFILE"`          // This variable holds the filepath to the rate_limits.json
+	KeyAddr        string `json:"KEYADDRESS"`     // The keystore address of the node's identity (node.key if not set)
+	KeyPass        string `json:"KEYPASSFILE"`    // The filepath to the passphrase of the keystore key
+	HealthInterval int    `json:"HEALTHINTERVAL"` // The time between health checks of the hosted chains in seconds
+	SyncLag        int    `json:"SYNCLAG"`        // The number of blocks a hosted chain may lag behind and still be dispatched
+	CacheSize      int    `json:"CACHESIZE"`      // The memory cap of the relay response cache in megabytes (0 disables it)
+	RelayTimeout   int    `json:"RELAYTIMEOUT"`   // The default time to wait for a hosted chain's response to a relay (ms)
+	PeerTimeout    int    `json:"PEERTIMEOUT"`    // The timeout for http requests between nodes (ms)
+	MaxIdleConns   int    `json:"MAXIDLECONNS"`   // The idle keep-alive connections kept per host
+	LogLevel       string `json:"LOGLEVEL"`       // The minimum level of logged messages
+	LogOutput      string `json:"LOGOUTPUT"`      // Where the logs are written (file, stdout or both)
+	LogMaxSize     int    `json:"LOGMAXSIZE"`     // The size in megabytes after which the log file is rotated
+	LogMaxAge      int    `json:"LOGMAXAGE"`      // The time in days rotated log files are kept
+	LogMaxBackups  int    `json:"LOGMAXBACKUPS"`  // The number of rotated log files kept
 }
 
 var (
@@ -54,6 +76,26 @@ var (
 	disrport       = flag.String("disrport", _const.DISPATCHRELAYPORT, "specifies the relay port of the centralized dispatcher")
 	peerrefresh    = flag.Int("peerrefresh", _const.DBREFRESH, "specifies the peer refresh time for the centralized dispatcher liveness checks")
 	requestTimeout = flag.Int("requestTimeout", _const.TIMEOUT, "specifies the timeout for http requests (ms)")
+	peerStore      = flag.String("peerstore", _const.DBDYNAMO, "specifies the storage backend for the dispatcher's peers (dynamo, bolt, memory)")
+	disStrategy    = flag.String("disstrategy", _const.STRATRANDOM, "specifies the load balancing strategy of the dispatcher ("+strings.Join(_const.STRATEGIES, ", ")+")")
+	disCount       = flag.Int("discount", _const.DISPATCHCOUNT, "specifies the default number of nodes served per blockchain by the dispatcher (0 is every node)")
+	sessLength     = flag.Int("sesslength", _const.SESSIONLENGTH, "specifies the length of a developer session epoch in seconds")
+	sessNodes      = flag.Int("sessnodes", _const.SESSIONNODES, "specifies the number of nodes within a developer session")
+	lFile          = flag.String("lfile", _const.LIMITSFILENAMEPLACEHOLDER, "specifies the filepath for rate_limits.json (defaults to the directory of developer_whitelist.json)")
+	sessCheck      = flag.Bool("sesscheck", false, "whether or not the service node rejects relays that don't belong to one of its sessions")
+	keyAddr        = flag.String("keyaddress", "", "specifies the keystore address of the node's identity (uses node.key within the data directory if not set)")
+	keyPass        = flag.String("keypassfile", "", "specifies the filepath to the passphrase of the keystore address")
+	healthInterval = flag.Int("healthinterval", _const.HEALTHINTERVAL, "specifies the time between health checks of the hosted chains in seconds")
+	syncLag        = flag.Int("synclag", _const.SYNCLAG, "specifies the number of blocks a hosted chain may lag behind its reference (or the median peer) and still be dispatched")
+	cacheSize      = flag.Int("cachesize", _const.CACHESIZE, "specifies the memory cap of the relay response cache in megabytes, 0 disables the cache (cached methods are configured with \"cache\" in chains.json)")
+	relayTimeout   = flag.Int("relaytimeout", _const.RELAYTIMEOUT, "specifies the default time to wait for a hosted chain's response to a relay (ms), overridden by \"timeout\" in chains.json")
+	peerTimeout    = flag.Int("peertimeout", _const.PEERTIMEOUT, "specifies the timeout for http requests between nodes (ms)")
+	maxIdleConns   = flag.Int("maxidleconns", _const.MAXIDLECONNS, "specifies the idle keep-alive connections kept per upstream, dispatcher or peer")
+	logLevel       = flag.String("loglevel", _const.LOGLEVEL, "specifies the minimum level of logged messages (trace, debug, info, warning, error, fatal or panic)")
+	logOutput      = flag.String("logoutput", _const.LOGOUTPUT, "specifies where the logs are written (file, stdout or both)")
+	logMaxSize     = flag.Int("logmaxsize", _const.LOGMAXSIZE, "specifies the size in megabytes after which the log file is rotated")
+	logMaxAge      = flag.Int("logmaxage", _const.LOGMAXAGE, "specifies the time in days rotated log files are kept")
+	logMaxBackups  = flag.Int("logmaxbackups", _const.LOGMAXBACKUPS, "specifies the number of rotated log files kept")
 )
 
 // "Init" initializes the configuration object.
@@ -64,6 +106,19 @@ func Init() {
 	filePaths()
 	// returns the thread safe c of the client configuration.
 	GlobalConfig()
+	// exits on an invalid configuration
+	validate()
+}
+
+// "validate" exits if a flag is set to an unknown value.
+func validate() {
+	for _, s := range _const.STRATEGIES {
+		if GlobalConfig().DisStrategy == s {
+			return
+		}
+	}
+	// doesn't use custom logs, because they may or may not be available at this point
+	log.Fatalf("unknown dispatch strategy %q, valid strategies: %s", GlobalConfig().DisStrategy, strings.Join(_const.STRATEGIES, ", "))
 }
 
 // "Print()" prints the client configuration information to the CLI.
@@ -102,5 +157,25 @@ func newConfiguration() {
 		*disip,
 		*disrport,
 		*peerrefresh,
-		*requestTimeout}
+		*requestTimeout,
+		*peerStore,
+		*disStrategy,
+		*disCount,
+		*sessLength,
+		*sessNodes,
+		*sessCheck,
+		*lFile,
+		*keyAddr,
+		*keyPass,
+		*healthInterval,
+		*syncLag,
+		*cacheSize,
+		*relayTimeout,
+		*peerTimeout,
+		*maxIdleConns,
+		*logLevel,
+		*logOutput,
+		*logMaxSize,
+		*logMaxAge,
+		*logMaxBackups}
 }
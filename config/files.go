@@ -23,16 +23,20 @@ func WriteFixtures() error {
 	if err != nil {
 		return err
 	}
-	err = copyFile(cFile, datadirectory+"chains.json")
+	lf, err := filepath.Abs("config" + _const.FILESEPARATOR + "fixtures" + _const.FILESEPARATOR + "rate_limits.json")
 	if err != nil {
 		return err
 	}
-	copyFile(dwl, datadirectory+"developer_whitelist.json")
-	if err != nil {
+	if err := copyFile(cFile, datadirectory+"chains.json"); err != nil {
 		return err
 	}
-	copyFile(swl, datadirectory+"service_whitelist.json")
-	if err != nil {
+	if err := copyFile(dwl, datadirectory+"developer_whitelist.json"); err != nil {
+		return err
+	}
+	if err := copyFile(swl, datadirectory+"service_whitelist.json"); err != nil {
+		return err
+	}
+	if err := copyFile(lf, datadirectory+"rate_limits.json"); err != nil {
 		return err
 	}
 	return nil
@@ -48,6 +52,10 @@ func filePaths() {
 	if *dwl == _const.DWLFILENAMEPLACEHOLDER {
 		*dwl = *dd + _const.FILESEPARATOR + "developer_whitelist.json"
 	}
+	// the rate limits live next to the developer whitelist
+	if *lFile == _const.LIMITSFILENAMEPLACEHOLDER {
+		*lFile = filepath.Dir(*dwl) + _const.FILESEPARATOR + "rate_limits.json"
+	}
 }
 
 func copyFile(src, dst string) error {
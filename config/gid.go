@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/hex"
 	"errors"
 	"io/ioutil"
 	"log"
@@ -8,58 +9,82 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/pokt-network/pocket-core/const"
 	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/pokt-network/pocket-core/crypto/keystore"
+	"golang.org/x/crypto/ed25519"
 )
 
-func gidHash() string {
-	hashString, err := crypto.NewSHA1Hash()
-	if err != nil {
-		log.Fatalf(err.Error())
-	}
-	return *gid + ":" + hashString
+var nodeKey ed25519.PrivateKey
+
+// "NodeKey" returns the private key of the node's identity (available after GIDSetup).
+func NodeKey() ed25519.PrivateKey {
+	return nodeKey
 }
 
-func checkPrefix(gid string, query string) error {
-	if index := strings.IndexByte(query, ':'); index > 0 {
-		prefix := query[:index]
-		if prefix != gid {
-			return errors.New("incorrect gid prefix")
-		}
-		return nil
+// "gidFromKey" derives the GID from the GID prefix and the public key.
+func gidFromKey(priv ed25519.PrivateKey) string {
+	return *gid + ":" + hex.EncodeToString(priv.Public().(ed25519.PublicKey))
+}
+
+// "loadNodeKey" decrypts the node key from the keystore if an address is configured, else loads (or creates) node.key.
+func loadNodeKey() (ed25519.PrivateKey, error) {
+	if *keyAddr == "" {
+		return crypto.LoadOrCreateKey(filepath.FromSlash(*dd + "/" + _const.KEYFILENAME))
+	}
+	if *keyPass == "" {
+		return nil, errors.New("a keystore address requires a passphrase file (-keypassfile)")
 	}
-	return errors.New("prefix not detected ':' not found")
+	b, err := ioutil.ReadFile(*keyPass)
+	if err != nil {
+		return nil, err
+	}
+	k, err := keystore.New(filepath.FromSlash(*dd+"/"+_const.KEYSTOREDIR)).Key(*keyAddr, strings.TrimRight(string(b), "\r\n"))
+	if err != nil {
+		return nil, err
+	}
+	return k.PrivateKey, nil
 }
 
-func createGIDFile(filepath string) string {
-	f, err := os.Create(filepath)
+// "GIDSetup" loads (or creates) the node key within the data directory and derives the GID from it. ->
+// GID prefix + hex(public key)
+func GIDSetup() string {
+	key, err := loadNodeKey()
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
-	s := gidHash()
-	f.Write([]byte(s))
-	return s
+	nodeKey = key
+	g := gidFromKey(key)
+	if err := migrateGIDFile(filepath.FromSlash(*dd+"/"+_const.GIDFILENAME), g); err != nil {
+		log.Fatalf(err.Error())
+	}
+	return g
 }
 
-// "GIDSetup" creates a unique from the GID prefix. ->
-// GID prefix + random_hash
-func GIDSetup() string {
-	fp := filepath.FromSlash(*dd + "/gid.dat")
-	if _, err := os.Stat(fp); err == nil { // file exists
-		b, err := ioutil.ReadFile(fp)
-		err2 := checkPrefix(*gid, string(b))
-		// unable to read file or prefix is not the same as gid
-		if err != nil || err2 != nil {
-			err := os.Remove(fp)
-			if err != nil { // unable to delete file
-				log.Fatalf(err.Error())
-			}
-			return createGIDFile(fp)
+// "migrateGIDFile" writes the GID to the gid file (kept for reference, the key is the source of the identity).
+// A GID generated before node keys (prefix + ":" + sha1 of math/rand bytes) is predictable,
+// so it is moved aside to the legacy gid file and replaced.
+func migrateGIDFile(fp string, g string) error {
+	b, err := ioutil.ReadFile(fp)
+	if err == nil && string(b) == g {
+		return nil
+	}
+	if err == nil && isLegacyGID(string(b)) {
+		legacy := filepath.Join(filepath.Dir(fp), _const.LEGACYGIDFILENAME)
+		if err := os.Rename(fp, legacy); err != nil {
+			return err
 		}
-		return string(b)
-	} else if os.IsNotExist(err) {
-		return createGIDFile(fp)
-	} else {
-		log.Fatalf(err.Error())
+		log.Println("Migrated the legacy GID " + string(b) + " to " + g + " (the previous GID is kept in " + legacy + ")")
+	}
+	return ioutil.WriteFile(fp, []byte(g), 0644)
+}
+
+// "isLegacyGID" returns whether the GID wasn't derived from an ed25519 public key.
+func isLegacyGID(g string) bool {
+	index := strings.IndexByte(g, ':')
+	if index < 0 {
+		return true
 	}
-	return ""
+	pub, err := hex.DecodeString(g[index+1:])
+	return err != nil || len(pub) != ed25519.PublicKeySize
 }
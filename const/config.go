@@ -14,4 +14,14 @@ const (
 	CAPIVERSION = "0.0.1"
 	// http timeout in ms
 	TIMEOUT = 400
+	// the http header of the id correlating a request across the developer, dispatcher, service nodes and hosted chains
+	REQUESTIDHEADER = "X-Request-ID"
+	// the timeout in ms of http requests between nodes (registration, whitelists, sessions and liveness checks)
+	PEERTIMEOUT = 10000
+	// the idle keep-alive connections kept per host
+	MAXIDLECONNS = 64
+	// the time in seconds an idle keep-alive connection is kept
+	IDLECONNTIMEOUT = 90
+	// the time in seconds the detected scheme (http or https) of a host is cached
+	SCHEMETTL = 600
 )
@@ -6,4 +6,17 @@ import "crypto"
 const (
 	// defines the session hashing algorithm
 	SESSALGO = crypto.SHA1
+	// the length of a session epoch in seconds
+	SESSIONLENGTH = 3600
+	// the number of nodes within a session
+	SESSIONNODES = 5
+	// the directory of the encrypted key files (within the data directory)
+	KEYSTOREDIR = "keystore"
+	// the version of the encrypted key file format
+	KEYSTOREVERSION = 1
+	// the scrypt parameters used to derive the key file encryption key
+	SCRYPTN     = 1 << 18
+	SCRYPTR     = 8
+	SCRYPTP     = 1
+	SCRYPTDKLEN = 32
 )
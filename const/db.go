@@ -5,4 +5,9 @@ const (
 	DBREGION    = "us-east-2"
 	DBENDPOINT  = "dynamodb.us-east-2.amazonaws.com"
 	DBREFRESH   = 5
+	// peer storage backends
+	DBDYNAMO   = "dynamo"
+	DBBOLT     = "bolt"
+	DBMEMORY   = "memory"
+	DBFILENAME = "peers.db"
 )
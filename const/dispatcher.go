@@ -8,4 +8,18 @@ const (
 	DISMODENORMAL     = 0
 	DISMODEMIGRATE    = 1
 	DISMODEDEPRECATED = 2
+	// load balancing strategies of dispatch
+	STRATRANDOM      = "random"
+	STRATROUNDROBIN  = "roundrobin"
+	STRATLEASTSERVED = "leastserved"
+	STRATLATENCY     = "latency"
+	STRATHASH        = "hash"
+	STRATSESSION     = "session"
+	// how far a signed registration's timestamp may be from the dispatcher's clock (seconds)
+	REGISTRATIONWINDOW = 60
+	// the number of nodes served per blockchain (0 is every node)
+	DISPATCHCOUNT = 0
 )
+
+// the valid load balancing strategies of dispatch
+var STRATEGIES = []string{STRATRANDOM, STRATROUNDROBIN, STRATLEASTSERVED, STRATLATENCY, STRATHASH, STRATSESSION}
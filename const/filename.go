@@ -2,7 +2,13 @@ package _const
 
 const (
 	REPORTFILENAMEPLACEHOLDER = "reports.json"
+	USAGEFILENAME             = "usage.json"
 	SNWLFILENAMEPLACEHOLDER   = "<your_data_directory>/service_whitelist.json"
 	DWLFILENAMEPLACEHOLDER    = "<your_data_directory>/developer_whitelist.json"
 	CHAINFILEPLACEHOLDER      = "<your_data_directory>/chains.json"
+	LIMITSFILENAMEPLACEHOLDER = "<your_data_directory>/rate_limits.json"
+	QUOTAFILENAME             = "quotas.json"
+	KEYFILENAME               = "node.key"
+	GIDFILENAME               = "gid.dat"
+	LEGACYGIDFILENAME         = "gid.dat.legacy"
 )
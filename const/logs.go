@@ -0,0 +1,20 @@
+package _const
+
+const (
+	// the directory of the log files (within the data directory)
+	LOGSDIR = "logs"
+	// the name of the current log file, rotated files are suffixed with the time of their rotation
+	LOGFILENAME = "pocket_core.log"
+	// the minimum level of logged messages (trace, debug, info, warning, error, fatal or panic)
+	LOGLEVEL = "info"
+	// where the logs are written (file, stdout or both)
+	LOGOUTPUT = "file"
+	// the size in megabytes after which the log file is rotated
+	LOGMAXSIZE = 100
+	// the time in hours after which the log file is rotated, regardless of its size
+	LOGROTATEINTERVAL = 24
+	// the time in days rotated log files are kept
+	LOGMAXAGE = 7
+	// the number of rotated log files kept
+	LOGMAXBACKUPS = 10
+)
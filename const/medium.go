@@ -0,0 +1,35 @@
+package _const
+
+const (
+	// the relay mediums of hosted chains
+	MEDIUMRPC  = "rpc"
+	MEDIUMWS   = "ws"
+	MEDIUMREST = "rest"
+	MEDIUMTCP  = "tcp"
+	// the number of idle websocket connections kept per hosted chain
+	WSPOOLSIZE = 8
+	// the time in seconds to wait for a websocket response from a hosted chain
+	WSTIMEOUT = 30
+	// the number of idle tcp connections kept per hosted chain
+	TCPPOOLSIZE = 8
+	// the default time in ms to wait for a tcp response from a hosted chain (overridden per chain in chains.json)
+	TCPTIMEOUT = 10000
+	// the default time in ms to wait for a hosted chain's response to a relay, failover included
+	RELAYTIMEOUT = 30000
+	// the maximum number of relays within a batch
+	MAXBATCHSIZE = 100
+	// the consecutive failures after which an upstream is taken out of rotation
+	UPSTREAMFAILURES = 3
+	// the time in seconds an upstream is out of rotation
+	UPSTREAMCOOLDOWN = 30
+	// the time in seconds between health checks of the hosted chains
+	HEALTHINTERVAL = 30
+	// the time in seconds to wait for a health check response
+	HEALTHTIMEOUT = 5
+	// the number of blocks a hosted chain may be behind its reference (or the median peer) and still serve relays
+	SYNCLAG = 5
+	// the default memory cap of the relay response cache in megabytes
+	CACHESIZE = 64
+	// the default number of blocks below the head of a hosted chain before relays referring to a block are cached
+	CACHEDEPTH = 64
+)
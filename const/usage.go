@@ -0,0 +1,8 @@
+package _const
+
+const (
+	// how often the relay usage counters are written to the data directory (seconds)
+	USAGEFLUSH = 60
+	// how long the hourly relay usage counters are kept (hours)
+	USAGERETENTION = 24 * 90
+)
@@ -0,0 +1,122 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/crypto"
+	"golang.org/x/crypto/scrypt"
+)
+
+// "keyFile" is the json structure of an encrypted key file.
+type keyFile struct {
+	Address string     `json:"address"` // hex encoded public key
+	Crypto  cryptoJSON `json:"crypto"`
+	Version int        `json:"version"`
+}
+
+// "cryptoJSON" holds the ciphertext and the parameters needed to decrypt it.
+type cryptoJSON struct {
+	Cipher     string    `json:"cipher"`
+	CipherText string    `json:"ciphertext"`
+	Nonce      string    `json:"nonce"`
+	KDF        string    `json:"kdf"`
+	KDFParams  kdfParams `json:"kdfparams"`
+}
+
+// "kdfParams" are the scrypt parameters of the key derivation.
+type kdfParams struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+const (
+	cipherName = "aes-256-gcm"
+	kdfName    = "scrypt"
+)
+
+// "ErrDecrypt" is returned when the passphrase is unable to decrypt a key file.
+var ErrDecrypt = errors.New("could not decrypt the key with the given passphrase")
+
+// "encrypt" seals the private key with a key derived from the passphrase.
+func encrypt(address string, priv []byte, passphrase string, n, p int) (*keyFile, error) {
+	salt, err := crypto.RandBytes(32)
+	if err != nil {
+		return nil, err
+	}
+	params := kdfParams{N: n, R: _const.SCRYPTR, P: p, DKLen: _const.SCRYPTDKLEN, Salt: hex.EncodeToString(salt)}
+	gcm, err := newGCM(passphrase, salt, params)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := crypto.RandBytes(gcm.NonceSize())
+	if err != nil {
+		return nil, err
+	}
+	// the address is authenticated, so a key file can't be renamed to another address
+	ct := gcm.Seal(nil, nonce, priv, []byte(address))
+	return &keyFile{
+		Address: address,
+		Crypto: cryptoJSON{
+			Cipher:     cipherName,
+			CipherText: hex.EncodeToString(ct),
+			Nonce:      hex.EncodeToString(nonce),
+			KDF:        kdfName,
+			KDFParams:  params,
+		},
+		Version: _const.KEYSTOREVERSION,
+	}, nil
+}
+
+// "decrypt" opens the private key of the key file with the passphrase.
+func decrypt(kf *keyFile, passphrase string) ([]byte, error) {
+	if kf.Version != _const.KEYSTOREVERSION {
+		return nil, errors.New("unsupported key file version")
+	}
+	if kf.Crypto.Cipher != cipherName || kf.Crypto.KDF != kdfName {
+		return nil, errors.New("unsupported key file cipher or kdf")
+	}
+	salt, err := hex.DecodeString(kf.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hex.DecodeString(kf.Crypto.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	ct, err := hex.DecodeString(kf.Crypto.CipherText)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(passphrase, salt, kf.Crypto.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, errors.New("invalid key file nonce")
+	}
+	priv, err := gcm.Open(nil, nonce, ct, []byte(kf.Address))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return priv, nil
+}
+
+// "newGCM" derives the encryption key from the passphrase and returns the AES-GCM cipher.
+func newGCM(passphrase string, salt []byte, params kdfParams) (cipher.AEAD, error) {
+	dk, err := scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.DKLen)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(dk)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,164 @@
+// This package stores the node's keys as passphrase encrypted files.
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/crypto"
+	"golang.org/x/crypto/ed25519"
+)
+
+const keyFileExt = ".json"
+
+// "ErrNotFound" is returned when no key file exists for an address.
+var ErrNotFound = errors.New("no key found for the address")
+
+// "Key" is a decrypted keypair, its address is the hex encoded public key.
+type Key struct {
+	Address    string
+	PrivateKey ed25519.PrivateKey
+}
+
+// "Keystore" is a directory of encrypted key files.
+type Keystore struct {
+	Dir     string
+	ScryptN int // scrypt cost parameter, lower values are only meant for testing
+	ScryptP int // scrypt parallelization parameter
+}
+
+// "New" returns the keystore within the directory.
+func New(dir string) *Keystore {
+	return &Keystore{Dir: dir, ScryptN: _const.SCRYPTN, ScryptP: _const.SCRYPTP}
+}
+
+// "Address" returns the address of the private key.
+func Address(priv ed25519.PrivateKey) string {
+	return hex.EncodeToString(priv.Public().(ed25519.PublicKey))
+}
+
+// "Generate" creates a new key and stores it encrypted with the passphrase.
+func (ks *Keystore) Generate(passphrase string) (*Key, error) {
+	_, priv, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	return ks.store(priv, passphrase)
+}
+
+// "Import" stores the hex encoded private key (or 32 byte seed) encrypted with the passphrase.
+func (ks *Keystore) Import(privHex string, passphrase string) (*Key, error) {
+	b, err := hex.DecodeString(strings.TrimSpace(privHex))
+	if err != nil {
+		return nil, err
+	}
+	var priv ed25519.PrivateKey
+	switch len(b) {
+	case ed25519.SeedSize:
+		priv = ed25519.NewKeyFromSeed(b)
+	case ed25519.PrivateKeySize:
+		priv = ed25519.NewKeyFromSeed(b[:ed25519.SeedSize])
+		if !bytes.Equal(b, priv) {
+			return nil, errors.New("the public half of the private key doesn't match its seed")
+		}
+	default:
+		return nil, errors.New("invalid private key length")
+	}
+	if _, err := os.Stat(ks.path(Address(priv))); err == nil {
+		return nil, errors.New("the key already exists within the keystore")
+	}
+	return ks.store(priv, passphrase)
+}
+
+// "Export" returns the hex encoded private key of the address.
+func (ks *Keystore) Export(address string, passphrase string) (string, error) {
+	k, err := ks.Key(address, passphrase)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(k.PrivateKey), nil
+}
+
+// "Key" decrypts the key of the address with the passphrase.
+func (ks *Keystore) Key(address string, passphrase string) (*Key, error) {
+	if b, err := hex.DecodeString(address); err != nil || len(b) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid address, expected a hex encoded public key")
+	}
+	b, err := ioutil.ReadFile(ks.path(address))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	kf := &keyFile{}
+	if err := json.Unmarshal(b, kf); err != nil {
+		return nil, err
+	}
+	priv, err := decrypt(kf, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if len(priv) != ed25519.PrivateKeySize || Address(priv) != kf.Address {
+		return nil, errors.New("the key file is corrupted")
+	}
+	return &Key{Address: kf.Address, PrivateKey: priv}, nil
+}
+
+// "List" returns the addresses of the stored keys, sorted.
+func (ks *Keystore) List() ([]string, error) {
+	files, err := ioutil.ReadDir(ks.Dir)
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]string, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), keyFileExt) {
+			continue
+		}
+		addrs = append(addrs, strings.TrimSuffix(f.Name(), keyFileExt))
+	}
+	sort.Strings(addrs)
+	return addrs, nil
+}
+
+// "store" encrypts the private key and writes its key file (readable only by the owner).
+func (ks *Keystore) store(priv ed25519.PrivateKey, passphrase string) (*Key, error) {
+	addr := Address(priv)
+	kf, err := encrypt(addr, priv, passphrase, ks.ScryptN, ks.ScryptP)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.MarshalIndent(kf, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(ks.Dir, 0700); err != nil {
+		return nil, err
+	}
+	// write to a temporary file first so a key file is never partially written
+	tmp := ks.path(addr) + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, ks.path(addr)); err != nil {
+		return nil, err
+	}
+	return &Key{Address: addr, PrivateKey: priv}, nil
+}
+
+// "path" returns the filepath of the key file of the address.
+func (ks *Keystore) path(address string) string {
+	return filepath.Join(ks.Dir, strings.ToLower(address)+keyFileExt)
+}
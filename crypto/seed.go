@@ -7,6 +7,8 @@ import (
 )
 
 // "GenerateSeed" creates the random seed from nanosecond.
+// math/rand is only used where predictability is harmless (e.g. random dispatch),
+// identities, keys and nonces are read from crypto/rand (see RandBytes and GenerateKey).
 func GenerateSeed() {
 	rand.Seed(time.Now().UTC().UnixNano())
 }
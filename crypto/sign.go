@@ -0,0 +1,63 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"golang.org/x/crypto/ed25519"
+)
+
+// "GenerateKey" creates a new ed25519 keypair from the operating system's CSPRNG.
+func GenerateKey() (ed25519.PublicKey, ed25519.PrivateKey, error) {
+	return ed25519.GenerateKey(rand.Reader)
+}
+
+// "LoadOrCreateKey" reads the hex encoded private key at path, creating it if it doesn't exist.
+func LoadOrCreateKey(path string) (ed25519.PrivateKey, error) {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		_, priv, err := GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(path, []byte(hex.EncodeToString(priv)), 0600); err != nil {
+			return nil, err
+		}
+		return priv, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	priv, err := hex.DecodeString(string(b))
+	if err != nil {
+		return nil, err
+	}
+	if len(priv) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid private key size in " + path)
+	}
+	return ed25519.PrivateKey(priv), nil
+}
+
+// "Sign" signs the message and returns the hex encoded signature.
+func Sign(priv ed25519.PrivateKey, message []byte) string {
+	return hex.EncodeToString(ed25519.Sign(priv, message))
+}
+
+// "Verify" checks the hex encoded signature of the message against the hex encoded public key.
+func Verify(pubHex string, message []byte, sigHex string) error {
+	pub, err := hex.DecodeString(pubHex)
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return errors.New("invalid public key")
+	}
+	sig, err := hex.DecodeString(sigHex)
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		return errors.New("invalid signature")
+	}
+	if !ed25519.Verify(ed25519.PublicKey(pub), message, sig) {
+		return errors.New("signature verification failed")
+	}
+	return nil
+}
@@ -1,15 +1,16 @@
 package crypto
 
 import (
+	"crypto/rand"
 	"crypto/sha1"
 	"fmt"
-	"math/rand"
+	"io"
 )
 
-// "RandBytes" returns a random string of bytes.
+// "RandBytes" returns a random string of bytes read from the operating system's CSPRNG.
 func RandBytes(n int) ([]byte, error) {
 	output := make([]byte, n)
-	_, err := rand.Read(output)
+	_, err := io.ReadFull(rand.Reader, output)
 	if err != nil {
 		return nil, err
 	}
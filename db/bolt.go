@@ -0,0 +1,88 @@
+package db
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pokt-network/pocket-core/node"
+	bolt "go.etcd.io/bbolt"
+)
+
+var peersBucket = []byte("peers")
+
+// "BoltStore" is an embedded on-disk peer store, for dispatchers that run without an external database.
+type BoltStore struct {
+	bdb *bolt.DB
+	w   watchers
+}
+
+// "NewBoltStore" opens (or creates) the peer store file at path.
+func NewBoltStore(path string) (*BoltStore, error) {
+	bdb, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = bdb.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(peersBucket)
+		return err
+	})
+	if err != nil {
+		bdb.Close()
+		return nil, err
+	}
+	return &BoltStore{bdb: bdb}, nil
+}
+
+// "Add" writes a node to disk.
+func (bs *BoltStore) Add(n node.Node) error {
+	b, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	err = bs.bdb.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(peersBucket).Put([]byte(n.GID), b)
+	})
+	if err != nil {
+		return err
+	}
+	bs.w.publish(PeerEvent{Type: PeerAdded, Node: n})
+	return nil
+}
+
+// "Remove" deletes a node from disk.
+func (bs *BoltStore) Remove(n node.Node) error {
+	err := bs.bdb.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(peersBucket).Delete([]byte(n.GID))
+	})
+	if err != nil {
+		return err
+	}
+	bs.w.publish(PeerEvent{Type: PeerRemoved, Node: n})
+	return nil
+}
+
+// "List" reads all nodes from disk.
+func (bs *BoltStore) List() ([]node.Node, error) {
+	nodes := make([]node.Node, 0)
+	err := bs.bdb.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(peersBucket).ForEach(func(_, v []byte) error {
+			var n node.Node
+			if err := json.Unmarshal(v, &n); err != nil {
+				return err
+			}
+			nodes = append(nodes, n)
+			return nil
+		})
+	})
+	return nodes, err
+}
+
+// "Watch" streams the changes made to the bolt store.
+func (bs *BoltStore) Watch(stop <-chan struct{}) (<-chan PeerEvent, error) {
+	return bs.w.subscribe(stop), nil
+}
+
+// "Close" releases the underlying file.
+func (bs *BoltStore) Close() error {
+	return bs.bdb.Close()
+}
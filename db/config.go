@@ -17,6 +17,7 @@ var (
 	dbOnce sync.Once
 )
 
+// "Database" is the DynamoDB backed PeerStore.
 type Database struct {
 	dynamo *dynamodb.DynamoDB
 	sync.Mutex
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"sync"
+
+	"github.com/pokt-network/pocket-core/node"
+)
+
+// "MemoryStore" is a non-persistent peer store, intended for testing and single process dispatchers.
+type MemoryStore struct {
+	peers map[string]node.Node // <GID><Node>
+	w     watchers
+	sync.Mutex
+}
+
+// "NewMemoryStore" is a constructor function for the in-memory peer store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{peers: make(map[string]node.Node)}
+}
+
+// "Add" puts a node into memory.
+func (ms *MemoryStore) Add(n node.Node) error {
+	ms.Lock()
+	ms.peers[n.GID] = n
+	ms.Unlock()
+	ms.w.publish(PeerEvent{Type: PeerAdded, Node: n})
+	return nil
+}
+
+// "Remove" deletes a node from memory.
+func (ms *MemoryStore) Remove(n node.Node) error {
+	ms.Lock()
+	delete(ms.peers, n.GID)
+	ms.Unlock()
+	ms.w.publish(PeerEvent{Type: PeerRemoved, Node: n})
+	return nil
+}
+
+// "List" returns all nodes in memory.
+func (ms *MemoryStore) List() ([]node.Node, error) {
+	ms.Lock()
+	defer ms.Unlock()
+	nodes := make([]node.Node, 0, len(ms.peers))
+	for _, n := range ms.peers {
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
+
+// "Watch" streams the changes made to the memory store.
+func (ms *MemoryStore) Watch(stop <-chan struct{}) (<-chan PeerEvent, error) {
+	return ms.w.subscribe(stop), nil
+}
@@ -1,50 +1,73 @@
 package db
 
 import (
+	"encoding/json"
 	"fmt"
-	"github.com/pokt-network/pocket-core/util"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"time"
 
-	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
 	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/dispatch"
 	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/metrics"
 	"github.com/pokt-network/pocket-core/node"
 	"github.com/pokt-network/pocket-core/service"
+	"github.com/pokt-network/pocket-core/util"
 )
 
-// "peersRefresh" updates the peerList and dispatchPeerList from the database every x time.
+// "peersRefresh" updates the peerList and dispatchPeerList from the peer store as it changes.
 func peersRefresh() {
 	for {
-		var items []node.Node
-		db := DB()
-		db.Lock()
-		output, err := DB().getAll()
+		s := Store()
+		// subscribe before listing, so no change between the two is missed
+		stop := make(chan struct{})
+		events, err := s.Watch(stop)
 		if err != nil {
 			fmt.Fprint(os.Stderr, err.Error())
-			db.Unlock()
-			logs.NewLog(err.Error(), logs.PanicLevel, logs.JSONLogFormat)
+			logs.NewLog(err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+			events = closedEvents()
 		}
-		// unmarshal the output from the database call
-		err = dynamodbattribute.UnmarshalListOfMaps(output.Items, &items)
-		if err != nil {
+		if err := RestorePeers(); err != nil {
 			fmt.Fprint(os.Stderr, err.Error())
-			db.Unlock()
-			logs.NewLog(err.Error(), logs.PanicLevel, logs.JSONLogFormat)
+			logs.NewLog(err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
 		}
 		pl := node.PeerList()
-		pl.Set(items)
-		pl.CopyToDP()
-		db.Unlock()
-		// every x minutes
+		for e := range events {
+			switch e.Type {
+			case PeerAdded:
+				pl.Add(e.Node)
+				node.DispatchPeers().Add(e.Node)
+			case PeerRemoved:
+				pl.Remove(e.Node)
+				node.DispatchPeers().Delete(e.Node)
+			}
+		}
+		// the watch ended, start over from a fresh listing
+		close(stop)
 		time.Sleep(time.Duration(config.GlobalConfig().PRefresh) * time.Second)
 	}
 }
 
-// "PeersRefresh" is a helper function that runs peersRefresh in a go routine
+// "RestorePeers" replays the peer store into the peerList and dispatchPeerList.
+func RestorePeers() error {
+	items, err := Store().List()
+	if err != nil {
+		return err
+	}
+	pl := node.PeerList()
+	pl.Set(items)
+	pl.CopyToDP()
+	return nil
+}
+
+// "PeersRefresh" is a helper function that restores the registered peers and runs peersRefresh in a go routine
 func PeersRefresh() {
 	if config.GlobalConfig().Dispatch {
+		if err := RestorePeers(); err != nil {
+			node.ExitGracefully("unable to restore peers from the peer store " + err.Error())
+		}
 		go peersRefresh()
 	}
 }
@@ -53,7 +76,7 @@ func PeersRefresh() {
 func checkPeers() {
 	for {
 		pl := node.PeerList()
-		db := DB()
+		s := Store()
 		dp := node.DispatchPeers()
 		for _, p := range pl.M {
 			p := p.(node.Node)
@@ -64,7 +87,12 @@ func checkPeers() {
 					fmt.Println("\n" + p.IP + " failed a liveness check from dispatcher at " + p.IP + ":" + p.RelayPort + "\n")
 					pl.Remove(p)
 					dp.Delete(p)
-					db.Remove(p)
+					dispatch.ForgetLatency(p.GID)
+					dispatch.ForgetSync(p.GID)
+					dispatch.ForgetServed(p.GID)
+					if err := s.Remove(p); err != nil {
+						logs.NewLog(p.GID+" - "+p.IP+" unable to remove from peer store: "+err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+					}
 					service.HandleReport(&service.Report{
 						IP:      p.IP,
 						Message: " failed a livenss check from dispatcher at " + p.IP + ":" + p.RelayPort + "\n"})
@@ -75,9 +103,15 @@ func checkPeers() {
 	}
 }
 
+// the failed liveness checks of service nodes
+var livenessFailures = metrics.NewCounter("pocket_liveness_check_failures_total", "The failed liveness checks of service nodes.")
+
 // "isAlive" checks a node and returns the status of that check.
 func isAlive(n node.Node) bool { // TODO handle scenarios where the error is on the dispatch node side
-	if resp, err := check(n); err != nil || resp == nil || resp.StatusCode < 200 {
+	start := time.Now()
+	resp, err := check(n)
+	if err != nil || resp == nil || resp.StatusCode < 200 {
+		livenessFailures.Inc()
 		if resp != nil {
 			logs.NewLog(n.GID+" - "+n.IP+" failed liveness check: "+resp.Status, logs.WaringLevel, logs.JSONLogFormat)
 		}
@@ -86,9 +120,38 @@ func isAlive(n node.Node) bool { // TODO handle scenarios where the error is on
 		}
 		return false
 	}
+	// draining the body keeps the connection alive for the next check
+	ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	// the liveness round trip feeds the latency weighted dispatch strategy
+	dispatch.ObserveLatency(n.GID, time.Since(start))
+	observeSync(n)
 	return true
 }
 
+// "observeSync" fetches the sync status of a live node, which is dropped from dispatch while it lags.
+func observeSync(n node.Node) {
+	u, err := util.URLProto(n.IP + ":" + n.RelayPort + "/v1/self")
+	if err != nil {
+		return
+	}
+	resp, err := util.PeerClient().Get(u)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	// nodes running an older version don't serve their status
+	if resp.StatusCode != http.StatusOK {
+		return
+	}
+	self := node.Node{}
+	if err := json.NewDecoder(resp.Body).Decode(&self); err != nil {
+		logs.NewLog(n.GID+" - "+n.IP+" returned an invalid status: "+err.Error(), logs.WaringLevel, logs.JSONLogFormat)
+		return
+	}
+	dispatch.ObserveSync(n.GID, self.Sync)
+}
+
 // "check" tests a node by doing an HTTP GET to API.
 func check(n node.Node) (*http.Response, error) {
 	u, err := util.URLProto(n.IP + ":" + n.RelayPort + "/v1/")
@@ -96,7 +159,11 @@ func check(n node.Node) (*http.Response, error) {
 		logs.NewLog(n.GID+" - "+n.IP+" lLiveness check error: "+err.Error(), logs.WaringLevel, logs.JSONLogFormat)
 		return nil, err
 	}
-	return http.Get(u)
+	resp, err := util.PeerClient().Get(u)
+	if err != nil {
+		util.ForgetScheme(u)
+	}
+	return resp, err
 }
 
 // "CheckPeers" is a helper function to checks each service node's liveness. Runs checkPeers() in a go routine.
@@ -12,27 +12,26 @@ import (
 )
 
 // "Add" 'puts' a node into the persistent data storage.
-func (db *Database) Add(n node.Node) (*dynamodb.PutItemOutput, error) {
+func (db *Database) Add(n node.Node) error {
 	db.Lock()
 	defer db.Unlock()
 	av, err := dynamodbattribute.MarshalMap(n)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	input := &dynamodb.PutItemInput{
 		Item:      av,
 		TableName: aws.String(config.GlobalConfig().DBTableName),
 	}
-	res, err := db.dynamo.PutItem(input)
-	if err != nil {
+	if _, err := db.dynamo.PutItem(input); err != nil {
 		fmt.Println(err.Error())
-		return nil, err
+		return err
 	}
-	return res, nil
+	return nil
 }
 
 // "Remove" 'deletes' a node from the persistent data storage.
-func (db *Database) Remove(n node.Node) (*dynamodb.DeleteItemOutput, error) {
+func (db *Database) Remove(n node.Node) error {
 	db.Lock()
 	defer db.Unlock()
 	input := &dynamodb.DeleteItemInput{
@@ -46,7 +45,29 @@ func (db *Database) Remove(n node.Node) (*dynamodb.DeleteItemOutput, error) {
 		},
 		TableName: aws.String(config.GlobalConfig().DBTableName),
 	}
-	return db.dynamo.DeleteItem(input)
+	_, err := db.dynamo.DeleteItem(input)
+	return err
+}
+
+// "List" returns all nodes from the persistent data storage.
+func (db *Database) List() ([]node.Node, error) {
+	var items []node.Node
+	db.Lock()
+	defer db.Unlock()
+	output, err := db.getAll()
+	if err != nil {
+		return nil, err
+	}
+	// unmarshal the output from the database call
+	if err := dynamodbattribute.UnmarshalListOfMaps(output.Items, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// "Watch" polls the database for changes, as dynamo has no change feed without streams.
+func (db *Database) Watch(stop <-chan struct{}) (<-chan PeerEvent, error) {
+	return pollWatch(db, stop), nil
 }
 
 // "getAll" returns all nodes from the database.
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/node"
+)
+
+// "PeerStore" is the persistent storage of the dispatcher's registered service nodes.
+type PeerStore interface {
+	// adds (or replaces) a node in the store
+	Add(n node.Node) error
+	// removes a node from the store
+	Remove(n node.Node) error
+	// returns every node within the store
+	List() ([]node.Node, error)
+	// streams changes to the store until stop is closed
+	Watch(stop <-chan struct{}) (<-chan PeerEvent, error)
+}
+
+// "EventType" describes the change that happened to a peer.
+type EventType int
+
+const (
+	PeerAdded EventType = iota + 1
+	PeerRemoved
+)
+
+// "PeerEvent" is a single change within a PeerStore.
+type PeerEvent struct {
+	Type EventType
+	Node node.Node
+}
+
+var (
+	store     PeerStore
+	storeOnce sync.Once
+)
+
+// "Store" returns the peer store specified by the configuration.
+func Store() PeerStore {
+	storeOnce.Do(func() {
+		var err error
+		store, err = NewStore(config.GlobalConfig().PeerStore)
+		if err != nil {
+			node.ExitGracefully("unable to open the peer store " + err.Error())
+		}
+	})
+	return store
+}
+
+// "NewStore" is a constructor function for each of the peer store backends.
+func NewStore(backend string) (PeerStore, error) {
+	switch backend {
+	case _const.DBDYNAMO:
+		return DB(), nil
+	case _const.DBBOLT:
+		return NewBoltStore(config.GlobalConfig().DD + _const.FILESEPARATOR + _const.DBFILENAME)
+	case _const.DBMEMORY:
+		return NewMemoryStore(), nil
+	}
+	return nil, errors.New("unsupported peer store: " + backend)
+}
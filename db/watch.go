@@ -0,0 +1,107 @@
+package db
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/node"
+)
+
+// "watchers" fans out peer events to every subscriber of a local store.
+type watchers struct {
+	subs map[chan PeerEvent]struct{}
+	mux  sync.Mutex
+}
+
+// "subscribe" returns a channel of peer events that is closed after stop is closed.
+// The channel is also closed if the subscriber falls too far behind, it should start over from a fresh listing.
+func (w *watchers) subscribe(stop <-chan struct{}) <-chan PeerEvent {
+	c := make(chan PeerEvent, 64)
+	w.mux.Lock()
+	if w.subs == nil {
+		w.subs = make(map[chan PeerEvent]struct{})
+	}
+	w.subs[c] = struct{}{}
+	w.mux.Unlock()
+	go func() {
+		<-stop
+		w.mux.Lock()
+		defer w.mux.Unlock()
+		if _, ok := w.subs[c]; ok {
+			delete(w.subs, c)
+			close(c)
+		}
+	}()
+	return c
+}
+
+// "publish" sends the event to every subscriber without blocking the writer.
+// A subscriber whose buffer is full would miss the event, so its channel is closed instead.
+func (w *watchers) publish(e PeerEvent) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	for c := range w.subs {
+		select {
+		case c <- e:
+		default:
+			delete(w.subs, c)
+			close(c)
+		}
+	}
+}
+
+// "pollWatch" watches a remote store by diffing its listing every refresh period.
+// Only the nodes added, changed or removed since the previous listing are sent.
+func pollWatch(s PeerStore, stop <-chan struct{}) <-chan PeerEvent {
+	c := make(chan PeerEvent, 64)
+	go func() {
+		defer close(c)
+		send := func(e PeerEvent) bool {
+			select {
+			case c <- e:
+				return true
+			case <-stop:
+				return false
+			}
+		}
+		prev := make(map[string]node.Node)
+		for {
+			nodes, err := s.List()
+			if err == nil {
+				cur := make(map[string]node.Node)
+				for _, n := range nodes {
+					cur[n.GID] = n
+					if p, ok := prev[n.GID]; ok && reflect.DeepEqual(p, n) {
+						continue
+					}
+					if !send(PeerEvent{Type: PeerAdded, Node: n}) {
+						return
+					}
+				}
+				for gid, n := range prev {
+					if _, ok := cur[gid]; !ok {
+						if !send(PeerEvent{Type: PeerRemoved, Node: n}) {
+							return
+						}
+					}
+				}
+				prev = cur
+			}
+			select {
+			case <-stop:
+				return
+			case <-time.After(time.Duration(config.GlobalConfig().PRefresh) * time.Second):
+			}
+		}
+	}()
+	return c
+}
+
+// "closedEvents" returns an already closed event channel.
+func closedEvents() <-chan PeerEvent {
+	c := make(chan PeerEvent)
+	close(c)
+	return c
+}
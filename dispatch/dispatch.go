@@ -2,44 +2,92 @@
 package dispatch
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
 	"strings"
 
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
 	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/metrics"
 	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/ratelimit"
+	"github.com/pokt-network/pocket-core/session"
 )
 
 type Dispatch struct {
 	DevID       string            `json:"devid"`
 	Blockchains []node.Blockchain `json:"blockchains"`
+	Count       int               `json:"count"` // the number of nodes per blockchain (0 is the dispatcher's default)
 }
 
 type DispatchServe struct {
-	Name  string   `json:"name"`
-	NetID string   `json:"netid"`
-	Ips   []string `json:"ips"`
+	Name    string   `json:"name"`
+	NetID   string   `json:"netid"`
+	Ips     []string `json:"ips"`
+	Session string   `json:"session,omitempty"` // the session key (session strategy only)
+	Epoch   int64    `json:"epoch,omitempty"`   // the session epoch (session strategy only)
 }
 
+// the dispatch requests served, by status code
+var requestCount = metrics.NewCounter("pocket_dispatch_requests_total", "The dispatch requests served, by status code.", "code")
+
 // NOTE: this call has been augmented for the Pocket Core MVP Centralized Dispatcher
 // "Serve" formats Dispatch PL for an API request.
-func Serve(dispatch *Dispatch) ([]byte, error, int) {
+// The logs of the request carry the request id of the context.
+func Serve(ctx context.Context, dispatch *Dispatch) ([]byte, error, int) {
+	res, err, code := serve(ctx, dispatch)
+	requestCount.Inc(strconv.Itoa(code))
+	return res, err, code
+}
+
+// "serve" selects the nodes of the requested blockchains.
+func serve(ctx context.Context, dispatch *Dispatch) ([]byte, error, int) {
 	if node.EnsureDWL(node.DWL(), dispatch.DevID) {
+		chains := make([]ratelimit.Chain, 0, len(dispatch.Blockchains))
+		for _, bc := range dispatch.Blockchains {
+			chains = append(chains, ratelimit.Chain{Blockchain: bc.Name, NetID: bc.NetID})
+		}
+		if err := ratelimit.Check(dispatch.DevID, chains...); err != nil {
+			return nil, err, 429
+		}
 		var result []DispatchServe
+		strategy := GetStrategy(config.GlobalConfig().DisStrategy)
 		for _, bc := range dispatch.Blockchains {
 			ips := make([]string, 0)
-			nodes := node.DispatchPeers().PeersByChain(bc)
+			nodes := InSync(bc, node.DispatchPeers().NodesByChain(bc))
+			if count := serveCount(dispatch.Count, len(nodes)); count > 0 {
+				nodes = strategy.Select(dispatch.DevID, bc, nodes, count)
+			}
 			for _, n := range nodes {
 				ips = append(ips, n.IP+":"+n.RelayPort)
 			}
-			result = append(result, DispatchServe{Name: strings.ToUpper(bc.Name), NetID: strings.ToUpper(bc.NetID), Ips: ips})
+			serve := DispatchServe{Name: strings.ToUpper(bc.Name), NetID: strings.ToUpper(bc.NetID), Ips: ips}
+			if config.GlobalConfig().DisStrategy == _const.STRATSESSION {
+				serve.Epoch = session.CurrentEpoch()
+				serve.Session = session.Key(dispatch.DevID, bc, serve.Epoch)
+			}
+			result = append(result, serve)
 		}
 		res, err := json.MarshalIndent(result, "", "  ")
 		if err != nil {
+			logs.NewContextLog(ctx, "Couldn't convert node array to json array: "+err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
 			return nil, err, 500
-			logs.NewLog("Couldn't convert node array to json array: "+err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
 		}
 		return res, nil, 200
 	}
 	return []byte(""), errors.New("invalid Credentials"), 401
 }
+
+// "serveCount" returns the number of nodes to select out of available, 0 meaning none to select from.
+func serveCount(requested int, available int) int {
+	if requested <= 0 {
+		requested = config.GlobalConfig().DisCount
+	}
+	if requested <= 0 || requested > available {
+		return available
+	}
+	return requested
+}
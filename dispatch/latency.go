@@ -0,0 +1,46 @@
+package dispatch
+
+import (
+	"sync"
+	"time"
+)
+
+// the latency assumed for a node that has not been observed yet
+const defaultLatency = 500 * time.Millisecond
+
+var (
+	latencies   = make(map[string]time.Duration) // <GID><moving average>
+	latencyLock sync.Mutex
+)
+
+// "ObserveLatency" records a round trip to a node into its moving average.
+func ObserveLatency(gid string, d time.Duration) {
+	latencyLock.Lock()
+	defer latencyLock.Unlock()
+	if d <= 0 {
+		d = time.Millisecond
+	}
+	if avg, ok := latencies[gid]; ok {
+		// exponentially weighted, favoring the latest observations
+		latencies[gid] = (avg*7 + d*3) / 10
+		return
+	}
+	latencies[gid] = d
+}
+
+// "Latency" returns the moving average latency of a node.
+func Latency(gid string) time.Duration {
+	latencyLock.Lock()
+	defer latencyLock.Unlock()
+	if avg, ok := latencies[gid]; ok {
+		return avg
+	}
+	return defaultLatency
+}
+
+// "ForgetLatency" removes a node's latency observations.
+func ForgetLatency(gid string) {
+	latencyLock.Lock()
+	defer latencyLock.Unlock()
+	delete(latencies, gid)
+}
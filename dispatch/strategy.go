@@ -0,0 +1,188 @@
+package dispatch
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/session"
+)
+
+// "Strategy" selects which of the nodes of a blockchain are served to a developer.
+type Strategy interface {
+	// nodes are ordered by GID and count is within (0, len(nodes)]
+	Select(devID string, bc node.Blockchain, nodes []node.Node, count int) []node.Node
+}
+
+var (
+	strategies = map[string]Strategy{
+		_const.STRATRANDOM:      randomStrategy{},
+		_const.STRATROUNDROBIN:  &roundRobinStrategy{cursors: make(map[node.Blockchain]int)},
+		_const.STRATLEASTSERVED: &leastServedStrategy{served: make(map[string]time.Time)},
+		_const.STRATLATENCY:     latencyStrategy{},
+		_const.STRATHASH:        hashStrategy{},
+		_const.STRATSESSION:     sessionStrategy{},
+	}
+)
+
+// "GetStrategy" returns the strategy by name, falling back to random (config.Init rejects unknown names).
+func GetStrategy(name string) Strategy {
+	if s, ok := strategies[name]; ok {
+		return s
+	}
+	return strategies[_const.STRATRANDOM]
+}
+
+// "randomStrategy" serves k nodes uniformly at random.
+type randomStrategy struct{}
+
+func (randomStrategy) Select(_ string, _ node.Blockchain, nodes []node.Node, count int) []node.Node {
+	result := make([]node.Node, 0, count)
+	for _, i := range rand.Perm(len(nodes))[:count] {
+		result = append(result, nodes[i])
+	}
+	return result
+}
+
+// "roundRobinStrategy" serves the next k nodes of each blockchain in turn.
+type roundRobinStrategy struct {
+	cursors map[node.Blockchain]int
+	sync.Mutex
+}
+
+func (rr *roundRobinStrategy) Select(_ string, bc node.Blockchain, nodes []node.Node, count int) []node.Node {
+	rr.Lock()
+	start := rr.cursors[bc] % len(nodes)
+	rr.cursors[bc] = (start + count) % len(nodes)
+	rr.Unlock()
+	result := make([]node.Node, 0, count)
+	for i := 0; i < count; i++ {
+		result = append(result, nodes[(start+i)%len(nodes)])
+	}
+	return result
+}
+
+// "leastServedStrategy" serves the k nodes that were served the longest time ago.
+type leastServedStrategy struct {
+	served map[string]time.Time // <GID><last served>
+	sync.Mutex
+}
+
+func (ls *leastServedStrategy) Select(_ string, _ node.Blockchain, nodes []node.Node, count int) []node.Node {
+	ls.Lock()
+	defer ls.Unlock()
+	sorted := make([]node.Node, len(nodes))
+	copy(sorted, nodes)
+	// a zero time (never served) sorts first
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return ls.served[sorted[i].GID].Before(ls.served[sorted[j].GID])
+	})
+	now := time.Now()
+	for _, n := range sorted[:count] {
+		ls.served[n.GID] = now
+	}
+	return sorted[:count]
+}
+
+// "ForgetServed" removes the time a node was last served.
+func ForgetServed(gid string) {
+	ls := strategies[_const.STRATLEASTSERVED].(*leastServedStrategy)
+	ls.Lock()
+	defer ls.Unlock()
+	delete(ls.served, gid)
+}
+
+// "latencyStrategy" serves k nodes at random, weighted by the inverse of their observed latency.
+type latencyStrategy struct{}
+
+func (latencyStrategy) Select(_ string, _ node.Blockchain, nodes []node.Node, count int) []node.Node {
+	weights := make([]float64, len(nodes))
+	for i, n := range nodes {
+		weights[i] = 1 / Latency(n.GID).Seconds()
+	}
+	remaining := make([]node.Node, len(nodes))
+	copy(remaining, nodes)
+	result := make([]node.Node, 0, count)
+	for len(result) < count {
+		var total float64
+		for _, w := range weights {
+			total += w
+		}
+		r := rand.Float64() * total
+		i := 0
+		for ; i < len(weights)-1; i++ {
+			if r -= weights[i]; r < 0 {
+				break
+			}
+		}
+		result = append(result, remaining[i])
+		// sample without replacement
+		remaining = append(remaining[:i], remaining[i+1:]...)
+		weights = append(weights[:i], weights[i+1:]...)
+	}
+	return result
+}
+
+// the number of points each node has on the hash ring
+const virtualNodes = 64
+
+// "hashStrategy" serves the k nodes following the developer on a consistent hash ring,
+// so a developer keeps the same nodes as long as they are registered.
+type hashStrategy struct{}
+
+func (hashStrategy) Select(devID string, bc node.Blockchain, nodes []node.Node, count int) []node.Node {
+	type point struct {
+		hash uint64
+		node int
+	}
+	ring := make([]point, 0, len(nodes)*virtualNodes)
+	for i, n := range nodes {
+		for v := 0; v < virtualNodes; v++ {
+			ring = append(ring, point{ringHash(n.GID + "#" + strconv.Itoa(v)), i})
+		}
+	}
+	sort.Slice(ring, func(i, j int) bool { return ring[i].hash < ring[j].hash })
+	h := ringHash(devID + ":" + bc.Name + ":" + bc.NetID)
+	start := sort.Search(len(ring), func(i int) bool { return ring[i].hash >= h })
+	result := make([]node.Node, 0, count)
+	seen := make(map[int]struct{})
+	for i := 0; len(result) < count; i++ {
+		p := ring[(start+i)%len(ring)]
+		if _, ok := seen[p.node]; ok {
+			continue
+		}
+		seen[p.node] = struct{}{}
+		result = append(result, nodes[p.node])
+	}
+	return result
+}
+
+// "sessionStrategy" serves the nodes of the developer's current session, the same set for the whole epoch.
+type sessionStrategy struct{}
+
+func (sessionStrategy) Select(devID string, bc node.Blockchain, nodes []node.Node, count int) []node.Node {
+	if max := config.GlobalConfig().SessNodes; max > 0 && count > max {
+		count = max
+	}
+	epoch := session.CurrentEpoch()
+	s := &session.Session{Epoch: epoch, Key: session.Key(devID, bc, epoch)}
+	// the session nodes are in rank order, a smaller count is a subset of the session
+	result := s.Nodes(nodes)
+	if count > 0 && count < len(result) {
+		result = result[:count]
+	}
+	return result
+}
+
+// "ringHash" places a key on the hash ring.
+func ringHash(key string) uint64 {
+	sum := sha1.Sum([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
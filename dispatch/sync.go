@@ -0,0 +1,70 @@
+package dispatch
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/node"
+)
+
+var (
+	syncs    = make(map[string]map[node.Blockchain]node.SyncStatus) // <GID><chain><sync status>
+	syncLock sync.Mutex
+)
+
+// "ObserveSync" records the latest sync status reported by a node.
+func ObserveSync(gid string, ss []node.SyncStatus) {
+	syncLock.Lock()
+	defer syncLock.Unlock()
+	if len(ss) == 0 {
+		return
+	}
+	m := make(map[node.Blockchain]node.SyncStatus, len(ss))
+	for _, s := range ss {
+		m[s.Blockchain] = s
+	}
+	syncs[gid] = m
+}
+
+// "ForgetSync" removes a node's sync status.
+func ForgetSync(gid string) {
+	syncLock.Lock()
+	defer syncLock.Unlock()
+	delete(syncs, gid)
+}
+
+// "InSync" filters out the nodes that are behind on the blockchain, either by their own reference client
+// or by more than the sync lag compared to the median height of the nodes. Nodes that never reported a height are kept.
+// The heights are reported by the nodes, so the median is used: a single node reporting a height far ahead
+// can't exclude the others.
+func InSync(bc node.Blockchain, nodes []node.Node) []node.Node {
+	syncLock.Lock()
+	defer syncLock.Unlock()
+	var heights []uint64
+	for _, n := range nodes {
+		if s, ok := syncs[n.GID][bc]; ok {
+			heights = append(heights, s.Height)
+		}
+	}
+	median := medianHeight(heights)
+	lag := uint64(config.GlobalConfig().SyncLag)
+	result := make([]node.Node, 0, len(nodes))
+	for _, n := range nodes {
+		s, ok := syncs[n.GID][bc]
+		if ok && (!s.Synced || s.Height+lag < median) {
+			continue
+		}
+		result = append(result, n)
+	}
+	return result
+}
+
+// "medianHeight" returns the median of the heights (the lower one of an even count), 0 if there are none.
+func medianHeight(heights []uint64) uint64 {
+	if len(heights) == 0 {
+		return 0
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights[(len(heights)-1)/2]
+}
@@ -0,0 +1,195 @@
+// This package monitors the hosted chains, so only the healthy ones are advertised and relayed to.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/plugin"
+	"github.com/pokt-network/pocket-core/plugin/rpc"
+)
+
+// the default probes of chains without one in chains.json
+const (
+	ethProbe = `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`
+	btcProbe = `{"jsonrpc":"1.0","id":1,"method":"getblockcount","params":[]}`
+)
+
+// "Start" checks the hosted chains once, then periodically in the background.
+// The node re-registers with the dispatcher whenever the set of healthy chains changes.
+func Start() {
+	CheckChains()
+	// chains added by a reload of chains.json are probed before the node re-registers
+	node.SetProbe(checkChain)
+	go func() {
+		for {
+			time.Sleep(time.Duration(config.GlobalConfig().HealthInterval) * time.Second)
+			if CheckChains() && !config.GlobalConfig().Dispatch {
+				if err := node.Reregister(); err != nil {
+					logs.NewLog("unable to re-register the healthy chains: "+err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+				}
+			}
+		}
+	}()
+}
+
+// "CheckChains" probes every hosted chain, records its health and sync status and returns whether any chain's health changed.
+func CheckChains() bool {
+	changed := false
+	for _, bc := range node.ChainsSlice() {
+		if checkChain(bc) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// "checkChain" probes the hosted chain, records its health and sync status and returns whether its health changed.
+func checkChain(bc node.Blockchain) bool {
+	hc := node.ChainToHosted(bc)
+	height, err := Check(hc)
+	changed := node.SetChainHealth(bc, err == nil)
+	if changed {
+		if err != nil {
+			logs.NewLog(bc.Name+" NetID:"+bc.NetID+" is unhealthy and no longer advertised: "+err.Error(), logs.WaringLevel, logs.JSONLogFormat)
+		} else {
+			logs.NewLog(bc.Name+" NetID:"+bc.NetID+" recovered and is advertised again", logs.InfoLevel, logs.JSONLogFormat)
+		}
+	}
+	if err == nil && height > 0 {
+		node.SetSyncStatus(syncStatus(hc, height))
+	}
+	return changed
+}
+
+// "syncStatus" compares the height of the hosted chain to its reference client (if configured).
+func syncStatus(hc node.HostedChain, height uint64) node.SyncStatus {
+	s := node.SyncStatus{Blockchain: hc.Blockchain, Height: height, Synced: true}
+	if hc.Reference == "" {
+		return s
+	}
+	ref, err := referenceHeight(hc)
+	if err != nil {
+		logs.NewLog(hc.Name+" NetID:"+hc.NetID+" unable to reach the reference client: "+err.Error(), logs.WaringLevel, logs.JSONLogFormat)
+		return s
+	}
+	s.Reference = ref
+	s.Synced = ref <= height+uint64(config.GlobalConfig().SyncLag)
+	return s
+}
+
+// "referenceHeight" sends the chain's probe to its reference client.
+func referenceHeight(hc node.HostedChain) (uint64, error) {
+	p := Probe(hc)
+	if p == "" || hc.Medium == _const.MEDIUMREST {
+		return 0, errors.New("the chain has no json rpc probe to compare heights with")
+	}
+	u, err := url.ParseRequestURI(hc.Reference)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), _const.HEALTHTIMEOUT*time.Second)
+	defer cancel()
+	body, code, err := rpc.ExecuteRequest(ctx, []byte(p), u)
+	if err != nil {
+		return 0, err
+	}
+	if code != http.StatusOK {
+		return 0, errors.New("the reference client responded with status " + strconv.Itoa(code))
+	}
+	return parseResponse(body)
+}
+
+// "Check" probes the upstreams of the hosted chain, it is healthy if any upstream is.
+// The height of the chain is returned if its probe answers with one (0 otherwise).
+func Check(hc node.HostedChain) (uint64, error) {
+	var err error
+	var height uint64
+	for _, up := range hc.Endpoints() {
+		height, err = probe(hc.At(up))
+		node.ReportUpstream(up, err == nil)
+		if err == nil {
+			return height, nil
+		}
+	}
+	return 0, err
+}
+
+// "Probe" returns the health check request of the hosted chain, empty if only its connectivity can be checked.
+func Probe(hc node.HostedChain) string {
+	if hc.Probe != "" || hc.Medium == _const.MEDIUMREST {
+		return hc.Probe
+	}
+	name := strings.ToLower(hc.Name)
+	switch {
+	case strings.HasPrefix(name, "eth"):
+		return ethProbe
+	case strings.HasPrefix(name, "btc"), strings.HasPrefix(name, "bitcoin"):
+		return btcProbe
+	}
+	return ""
+}
+
+// "probe" sends the chain's probe to a single upstream (the hosted chain pointed at it).
+func probe(hc node.HostedChain) (uint64, error) {
+	p := Probe(hc)
+	if p == "" {
+		return 0, node.DialUpstream(hc.Medium, node.Upstream{Host: hc.Host, Port: hc.Port, Path: hc.Path})
+	}
+	relay := plugin.Relay{Blockchain: hc.Name, NetworkID: hc.NetID, Data: p}
+	if hc.Medium == _const.MEDIUMREST {
+		relay = plugin.Relay{Blockchain: hc.Name, NetworkID: hc.NetID, Method: http.MethodGet, Path: p}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), _const.HEALTHTIMEOUT*time.Second)
+	defer cancel()
+	resp, err := plugin.Execute(ctx, relay, hc)
+	if err != nil {
+		return 0, err
+	}
+	if resp.Code >= 400 {
+		return 0, errors.New("the probe returned " + strconv.Itoa(resp.Code))
+	}
+	if hc.Medium == _const.MEDIUMREST {
+		return 0, nil
+	}
+	return parseResponse(resp.Body)
+}
+
+// "parseResponse" checks the json rpc response of a probe and returns its result as a height (0 if it isn't one).
+func parseResponse(body string) (uint64, error) {
+	r := struct {
+		Result json.RawMessage `json:"result"`
+		Error  json.RawMessage `json:"error"`
+	}{}
+	if err := json.Unmarshal([]byte(body), &r); err != nil {
+		return 0, errors.New("the probe returned an invalid response: " + err.Error())
+	}
+	// a json rpc error means the client is up but can't serve
+	if len(r.Error) != 0 && string(r.Error) != "null" {
+		return 0, errors.New("the probe returned an error: " + string(r.Error))
+	}
+	// a hex quantity (e.g. eth_blockNumber) or a number (e.g. getblockcount)
+	var hex string
+	if err := json.Unmarshal(r.Result, &hex); err == nil {
+		h, err := strconv.ParseUint(strings.TrimPrefix(hex, "0x"), 16, 64)
+		if err != nil {
+			return 0, nil
+		}
+		return h, nil
+	}
+	var n uint64
+	if err := json.Unmarshal(r.Result, &n); err == nil {
+		return n, nil
+	}
+	return 0, nil
+}
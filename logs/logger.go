@@ -0,0 +1,94 @@
+package logs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/sirupsen/logrus"
+)
+
+// "logger" is the long-lived logger of the client, configured once from the config.
+type logger struct {
+	level logrus.Level  // the minimum level written
+	out   io.Writer     // the sinks
+	file  *RotatingFile // the file sink (nil if only logging to stdout)
+	mux   sync.Mutex    // serializes the entries written to the sinks
+}
+
+var (
+	lg     *logger
+	lgOnce sync.Once
+)
+
+// "get" returns the logger, configuring it on first use.
+func get() *logger {
+	lgOnce.Do(func() {
+		lg = newLogger()
+	})
+	return lg
+}
+
+// "newLogger" configures a logger from the config, falling back to stdout if the log file can't be opened.
+func newLogger() *logger {
+	c := config.GlobalConfig()
+	l := &logger{level: logrus.InfoLevel}
+	if lev, err := logrus.ParseLevel(c.LogLevel); err == nil {
+		l.level = lev
+	} else {
+		fmt.Fprintln(os.Stderr, "invalid log level "+c.LogLevel+", logging at info")
+	}
+	sinks := make([]io.Writer, 0, 2)
+	output := strings.ToLower(c.LogOutput)
+	if output == "stdout" || output == "both" {
+		sinks = append(sinks, os.Stdout)
+	}
+	if output != "stdout" {
+		f, err := NewRotatingFile(c.DD+_const.FILESEPARATOR+_const.LOGSDIR+_const.FILESEPARATOR+_const.LOGFILENAME, RotateConfig{
+			MaxSize:    int64(c.LogMaxSize) * 1024 * 1024,
+			Interval:   _const.LOGROTATEINTERVAL * time.Hour,
+			MaxAge:     time.Duration(c.LogMaxAge) * 24 * time.Hour,
+			MaxBackups: c.LogMaxBackups,
+		})
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "unable to open the log file, logging to stdout: "+err.Error())
+		} else {
+			l.file = f
+			sinks = append(sinks, f)
+		}
+	}
+	if len(sinks) == 0 {
+		sinks = append(sinks, os.Stdout)
+	}
+	l.out = io.MultiWriter(sinks...)
+	return l
+}
+
+// "write" formats the entry and writes it to the sinks if its level is enabled.
+// Unlike logrus' Fatal and Panic, no level exits the process or panics.
+func (l *logger) write(level logrus.Level, format logrus.Formatter, fields logrus.Fields, message string) error {
+	if level > l.level {
+		return nil
+	}
+	b, err := format.Format(&logrus.Entry{Data: fields, Time: time.Now(), Level: level, Message: message})
+	if err != nil {
+		return err
+	}
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	_, err = l.out.Write(b)
+	return err
+}
+
+// "Close" closes the log file, entries logged afterwards are only written to stdout (if configured).
+func Close() {
+	l := get()
+	if l.file != nil {
+		l.file.Close()
+	}
+}
@@ -2,13 +2,10 @@
 package logs
 
 import (
-	"os"
+	"context"
 	"runtime"
 	"strconv"
-	"time"
 
-	"github.com/pokt-network/pocket-core/config"
-	"github.com/pokt-network/pocket-core/const"
 	"github.com/sirupsen/logrus"
 )
 
@@ -31,15 +28,23 @@ func caller() *runtime.Frame {
 
 // "NewLog" creates a custom log and calls the logger function.
 func NewLog(message string, level LogLevel, format LogFormat) error {
-	currentTime := time.Now()
 	// get the caller from util
-	frame := caller()
+	return newLog(caller(), "", message, level, format)
+}
+
+// "NewContextLog" creates a custom log tagged with the request id of the context (see WithRequestID).
+func NewContextLog(ctx context.Context, message string, level LogLevel, format LogFormat) error {
+	return newLog(caller(), RequestID(ctx), message, level, format)
+}
+
+// "newLog" creates a custom log from the frame of its caller and calls the logger function.
+func newLog(frame *runtime.Frame, requestID string, message string, level LogLevel, format LogFormat) error {
 	if frame == nil {
 		panic("Frame from new log was nil")
 	}
 	// create a new log structure
 	log := Log{}
-	log.Name = currentTime.UTC().Format("2006-01-02T15-04-05")
+	log.RequestID = requestID
 	// set the name of the function
 	log.FunctionName = frame.Func.Name()
 	// set the path of the file
@@ -56,40 +61,19 @@ func NewLog(message string, level LogLevel, format LogFormat) error {
 	return nil
 }
 
-// "Logger" prints the log to data directory
+// "Logger" writes the log to the configured sinks (the rotated log file in the data directory and/or stdout).
 func Logger(l Log) error {
-	// open/create the new log file
-	f, err := os.OpenFile(config.GlobalConfig().DD+_const.FILESEPARATOR+"logs"+_const.FILESEPARATOR+l.Name, os.O_WRONLY|os.O_CREATE|os.O_APPEND, os.ModePerm)
-	if err != nil {
-		return err
+	fields := logrus.Fields{
+		"FilePath":     l.FilePath,     // the path of the file the log was called from
+		"LineNumber":   l.LineNumber,   // the line number of the file the log was called from
+		"FunctionName": l.FunctionName, // the function name of the file the log was called
 	}
-	defer f.Close()
-	logrus.SetFormatter(l.Fmt.format)
-	logrus.SetOutput(f)
-	lg := logrus.WithFields(
-		logrus.Fields{
-			"FilePath":     l.FilePath,     // the path of the file the log was called from
-			"LineNumber":   l.LineNumber,   // the line number of the file the log was called from
-			"FunctionName": l.FunctionName, // the function name of the file the log was called
-		})
-
-	switch l.Lev.level {
-	case logrus.InfoLevel:
-		lg.Info(l.Message)
-	case logrus.DebugLevel:
-		lg.Debug(l.Message)
-	case logrus.FatalLevel:
-		lg.Fatal(l.Message)
-	case logrus.ErrorLevel:
-		lg.Error(l.Message)
-	case logrus.PanicLevel:
-		lg.Panic(l.Message)
-	case logrus.WarnLevel:
-		lg.Warn(l.Message)
-	case logrus.TraceLevel:
-		lg.Trace(l.Message)
-	default:
-		lg.Info(l.Message)
+	if l.RequestID != "" {
+		fields["RequestID"] = l.RequestID
 	}
-	return nil
+	format := l.Fmt.format
+	if format == nil {
+		format = JSONLogFormat.format
+	}
+	return get().write(l.Lev.level, format, fields, l.Message)
 }
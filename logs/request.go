@@ -0,0 +1,22 @@
+package logs
+
+import (
+	"context"
+)
+
+// "requestIDKey" is the context key of the request id.
+type requestIDKey struct{}
+
+// "WithRequestID" returns a copy of the context carrying the request id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// "RequestID" returns the request id carried by the context, empty if none.
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
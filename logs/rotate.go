@@ -0,0 +1,151 @@
+package logs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// the time format of rotated log file names, sortable by time
+const rotatedFormat = "2006-01-02T15-04-05.000000000"
+
+// "RotateConfig" specifies when a log file is rotated and how long the rotated files are kept.
+type RotateConfig struct {
+	MaxSize    int64         // the size in bytes after which the file is rotated (0 for no limit)
+	Interval   time.Duration // the time after which the file is rotated regardless of its size (0 for no limit)
+	MaxAge     time.Duration // the time rotated files are kept (0 to keep them regardless of their age)
+	MaxBackups int           // the number of rotated files kept (0 to keep them all)
+}
+
+// "RotatingFile" is a log file that is rotated by size and age, safe for concurrent use.
+type RotatingFile struct {
+	path   string
+	conf   RotateConfig
+	file   *os.File // nil if closed or lost to a failed rotation, reopened on the next write unless closed
+	closed bool
+	size   int64
+	opened time.Time
+	mux    sync.Mutex
+}
+
+// "NewRotatingFile" opens (or creates) the log file at the path.
+func NewRotatingFile(path string, conf RotateConfig) (*RotatingFile, error) {
+	r := &RotatingFile{path: path, conf: conf}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// "Write" appends p to the log file, rotating it first if p would exceed its size or the file is past its interval.
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	if r.file == nil {
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	// an empty file is never rotated
+	full := r.conf.MaxSize > 0 && r.size+int64(len(p)) > r.conf.MaxSize
+	old := r.conf.Interval > 0 && time.Since(r.opened) > r.conf.Interval
+	if r.size > 0 && (full || old) {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// "Close" closes the log file.
+func (r *RotatingFile) Close() error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.closed = true
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// "open" opens the log file for appending.
+func (r *RotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.file, r.size, r.opened = f, info.Size(), time.Now()
+	return nil
+}
+
+// "rotate" renames the log file with the current time, opens a new one and removes the rotated files past retention.
+// On failure the log file is reopened, now or on the next write, so logging resumes once the cause is gone.
+func (r *RotatingFile) rotate() error {
+	err := r.file.Close()
+	r.file = nil
+	if err != nil {
+		return err
+	}
+	ext := filepath.Ext(r.path)
+	rotated := strings.TrimSuffix(r.path, ext) + "-" + time.Now().UTC().Format(rotatedFormat) + ext
+	if err := os.Rename(r.path, rotated); err != nil {
+		// keep appending to the unrotated file
+		r.open()
+		return err
+	}
+	if err := r.open(); err != nil {
+		return err
+	}
+	r.prune()
+	return nil
+}
+
+// "Rotated" returns the paths of the rotated log files, oldest first.
+func (r *RotatingFile) Rotated() []string {
+	ext := filepath.Ext(r.path)
+	prefix := filepath.Base(strings.TrimSuffix(r.path, ext)) + "-"
+	infos, err := ioutil.ReadDir(filepath.Dir(r.path))
+	if err != nil {
+		return nil
+	}
+	paths := make([]string, 0)
+	for _, info := range infos {
+		if !info.IsDir() && strings.HasPrefix(info.Name(), prefix) && strings.HasSuffix(info.Name(), ext) {
+			paths = append(paths, filepath.Join(filepath.Dir(r.path), info.Name()))
+		}
+	}
+	// the names are suffixed with the time of their rotation
+	sort.Strings(paths)
+	return paths
+}
+
+// "prune" removes the rotated files beyond the maximum count or age.
+func (r *RotatingFile) prune() {
+	paths := r.Rotated()
+	for i, p := range paths {
+		excess := r.conf.MaxBackups > 0 && len(paths)-i > r.conf.MaxBackups
+		expired := false
+		if info, err := os.Stat(p); err == nil && r.conf.MaxAge > 0 {
+			expired = time.Since(info.ModTime()) > r.conf.MaxAge
+		}
+		if excess || expired {
+			os.Remove(p)
+		}
+	}
+}
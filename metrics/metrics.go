@@ -0,0 +1,241 @@
+// This package exposes the runtime metrics of the node in the Prometheus text exposition format.
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// "DurationBuckets" are the default histogram buckets of durations in seconds.
+var DurationBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30}
+
+// "Sample" is a value of a metric collected when scraped, with the values of its labels.
+type Sample struct {
+	Labels []string
+	Value  float64
+}
+
+// "metric" is a registered metric.
+type metric interface {
+	// "write" writes the samples of the metric (without its help and type lines).
+	write(w *bufio.Writer)
+	header() (name, help, kind string)
+}
+
+var (
+	registry = make(map[string]metric) // <name><metric>
+	regMux   sync.Mutex
+)
+
+// "register" adds the metric to the registry, the name must be unique.
+func register(name string, m metric) {
+	regMux.Lock()
+	defer regMux.Unlock()
+	if _, ok := registry[name]; ok {
+		panic("metrics: " + name + " is already registered")
+	}
+	registry[name] = m
+}
+
+// "Write" writes every registered metric, ordered by name.
+func Write(w io.Writer) error {
+	regMux.Lock()
+	ms := make([]metric, 0, len(registry))
+	for _, m := range registry {
+		ms = append(ms, m)
+	}
+	regMux.Unlock()
+	sort.Slice(ms, func(i, j int) bool {
+		a, _, _ := ms[i].header()
+		b, _, _ := ms[j].header()
+		return a < b
+	})
+	bw := bufio.NewWriter(w)
+	for _, m := range ms {
+		name, help, kind := m.header()
+		bw.WriteString("# HELP " + name + " " + strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help) + "\n")
+		bw.WriteString("# TYPE " + name + " " + kind + "\n")
+		m.write(bw)
+	}
+	return bw.Flush()
+}
+
+// "desc" holds the name, help and label names of a metric.
+type desc struct {
+	name   string
+	help   string
+	labels []string
+}
+
+func (d desc) key(values []string) string {
+	if len(values) != len(d.labels) {
+		panic("metrics: " + d.name + " expects " + strconv.Itoa(len(d.labels)) + " label values")
+	}
+	return strings.Join(values, "\xff")
+}
+
+// "series" formats the name of a sample with its labels, extra is appended (e.g. the le of a bucket).
+func (d desc) series(suffix string, values []string, extra ...string) string {
+	pairs := make([]string, 0, len(values)+1)
+	for i, v := range values {
+		pairs = append(pairs, d.labels[i]+`="`+escape(v)+`"`)
+	}
+	if len(extra) == 2 {
+		pairs = append(pairs, extra[0]+`="`+escape(extra[1])+`"`)
+	}
+	if len(pairs) == 0 {
+		return d.name + suffix
+	}
+	return d.name + suffix + "{" + strings.Join(pairs, ",") + "}"
+}
+
+// "escape" escapes a label value.
+func escape(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+}
+
+// "format" formats a sample value.
+func format(v float64) string {
+	if math.IsInf(v, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// "sortedKeys" returns the keys of the series, so they are written in a stable order.
+func sortedKeys(keys []string) []string {
+	sort.Strings(keys)
+	return keys
+}
+
+// "Counter" is a monotonically increasing value per set of label values.
+type Counter struct {
+	desc
+	values      map[string]float64
+	labelValues map[string][]string // <key><label values>
+	mux         sync.Mutex
+}
+
+// "NewCounter" registers a counter with the label names.
+func NewCounter(name, help string, labels ...string) *Counter {
+	c := &Counter{desc: desc{name, help, labels}, values: make(map[string]float64), labelValues: make(map[string][]string)}
+	register(name, c)
+	return c
+}
+
+// "Inc" adds one to the counter of the label values.
+func (c *Counter) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// "Add" adds v (which must not be negative) to the counter of the label values.
+func (c *Counter) Add(v float64, values ...string) {
+	k := c.key(values)
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.values[k] += v
+	c.labelValues[k] = values
+}
+
+func (c *Counter) header() (string, string, string) { return c.name, c.help, "counter" }
+
+func (c *Counter) write(w *bufio.Writer) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	keys := make([]string, 0, len(c.values))
+	for k := range c.values {
+		keys = append(keys, k)
+	}
+	for _, k := range sortedKeys(keys) {
+		w.WriteString(c.series("", c.labelValues[k]) + " " + format(c.values[k]) + "\n")
+	}
+}
+
+// "GaugeFunc" is a value per set of label values, collected when scraped.
+type GaugeFunc struct {
+	desc
+	collect func() []Sample
+}
+
+// "NewGaugeFunc" registers a gauge collected by f with the label names.
+func NewGaugeFunc(name, help string, f func() []Sample, labels ...string) *GaugeFunc {
+	g := &GaugeFunc{desc: desc{name, help, labels}, collect: f}
+	register(name, g)
+	return g
+}
+
+func (g *GaugeFunc) header() (string, string, string) { return g.name, g.help, "gauge" }
+
+func (g *GaugeFunc) write(w *bufio.Writer) {
+	samples := g.collect()
+	sort.Slice(samples, func(i, j int) bool { return g.key(samples[i].Labels) < g.key(samples[j].Labels) })
+	for _, s := range samples {
+		w.WriteString(g.series("", s.Labels) + " " + format(s.Value) + "\n")
+	}
+}
+
+// "Histogram" counts observations into cumulative buckets per set of label values.
+type Histogram struct {
+	desc
+	buckets []float64 // the upper bounds, ascending
+	hists   map[string]*histogram
+	mux     sync.Mutex
+}
+
+type histogram struct {
+	labels []string
+	counts []uint64 // per bucket, not cumulative
+	count  uint64
+	sum    float64
+}
+
+// "NewHistogram" registers a histogram with the bucket upper bounds (ascending) and the label names.
+func NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	h := &Histogram{desc: desc{name, help, labels}, buckets: buckets, hists: make(map[string]*histogram)}
+	register(name, h)
+	return h
+}
+
+// "Observe" adds the value to the histogram of the label values.
+func (h *Histogram) Observe(v float64, values ...string) {
+	k := h.key(values)
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	s, ok := h.hists[k]
+	if !ok {
+		s = &histogram{labels: values, counts: make([]uint64, len(h.buckets))}
+		h.hists[k] = s
+	}
+	if i := sort.SearchFloat64s(h.buckets, v); i < len(h.buckets) {
+		s.counts[i]++
+	}
+	s.count++
+	s.sum += v
+}
+
+func (h *Histogram) header() (string, string, string) { return h.name, h.help, "histogram" }
+
+func (h *Histogram) write(w *bufio.Writer) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	keys := make([]string, 0, len(h.hists))
+	for k := range h.hists {
+		keys = append(keys, k)
+	}
+	for _, k := range sortedKeys(keys) {
+		s := h.hists[k]
+		var cumulative uint64
+		for i, b := range h.buckets {
+			cumulative += s.counts[i]
+			w.WriteString(h.series("_bucket", s.labels, "le", format(b)) + " " + strconv.FormatUint(cumulative, 10) + "\n")
+		}
+		w.WriteString(h.series("_bucket", s.labels, "le", "+Inf") + " " + strconv.FormatUint(s.count, 10) + "\n")
+		w.WriteString(h.series("_sum", s.labels) + " " + format(s.sum) + "\n")
+		w.WriteString(h.series("_count", s.labels) + " " + strconv.FormatUint(s.count, 10) + "\n")
+	}
+}
@@ -5,13 +5,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/pokt-network/pocket-core/util"
 	"io/ioutil"
-	"net/http"
+	"net"
 	"net/url"
-	"os"
+	"path"
+	"reflect"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/util"
 )
 
 // A structure that specifies a non-native blockchain.
@@ -23,10 +30,62 @@ type Blockchain struct {
 // A structure that specifies a non-native blockchain client running on a port.
 type HostedChain struct {
 	Blockchain `json:"blockchain"`
-	Port       string `json:"port"`
-	Host       string `json:"host"`
-	Path       string `json:"path"`   // url path for token based authentication
-	Medium     string `json:"medium"` // http, ws, tcp, etc.
+	Port       string      `json:"port"`
+	Host       string      `json:"host"`
+	Path       string      `json:"path"`                 // url path for token based authentication
+	Medium     string      `json:"medium"`               // rpc (http), ws, etc. the name of a plugin registered with the plugin package
+	Timeout    int         `json:"timeout,omitempty"`    // the timeout of relays in ms, the relaytimeout flag if not set
+	Upstreams  []Upstream  `json:"upstreams,omitempty"`  // the endpoints to fail over between, instead of host, port and path
+	Probe      string      `json:"probe,omitempty"`      // the health check request (a rest path for the rest medium), see the health package
+	Reference  string      `json:"reference,omitempty"`  // the url of a json rpc client the chain's head is compared to
+	Cache      []CacheRule `json:"cache,omitempty"`      // the json rpc methods whose responses are cached, see the cache package
+	CacheDepth int         `json:"cachedepth,omitempty"` // the blocks below the head before a block may be cached (CACHEDEPTH if not set)
+	Allow      []string    `json:"allow,omitempty"`      // the json rpc method patterns relayed (e.g. "eth_*"), every method if not set
+	Deny       []string    `json:"deny,omitempty"`       // the json rpc method patterns never relayed (e.g. "admin_*"), overrides allow
+}
+
+// A structure that specifies how long the responses of a json rpc method are cached.
+type CacheRule struct {
+	Method string `json:"method"`
+	TTL    int    `json:"ttl"` // in seconds
+}
+
+// "MethodAllowed" returns whether the json rpc method may be relayed to the hosted chain.
+// Patterns are matched with path.Match, so "*" matches any sequence of characters.
+// Malformed patterns are rejected when chains.json is read (see jsonToChains).
+func (hc HostedChain) MethodAllowed(method string) bool {
+	for _, p := range hc.Deny {
+		if ok, _ := path.Match(p, method); ok {
+			return false
+		}
+	}
+	if len(hc.Allow) == 0 {
+		return true
+	}
+	for _, p := range hc.Allow {
+		if ok, _ := path.Match(p, method); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// "Finality" returns the number of blocks below the chain's head after which a block is final enough to be cached.
+func (hc HostedChain) Finality() uint64 {
+	if hc.CacheDepth > 0 {
+		return uint64(hc.CacheDepth)
+	}
+	return _const.CACHEDEPTH
+}
+
+// "CacheTTL" returns how long the responses of the method are cached, 0 if they aren't.
+func (hc HostedChain) CacheTTL(method string) time.Duration {
+	for _, r := range hc.Cache {
+		if r.Method == method {
+			return time.Duration(r.TTL) * time.Second
+		}
+	}
+	return 0
 }
 
 var (
@@ -45,6 +104,8 @@ func Chains() map[Blockchain]HostedChain {
 
 // "ChainsSlice" converts the chains structure into a slice of type Blockchain.
 func ChainsSlice() []Blockchain {
+	mux.Lock()
+	defer mux.Unlock()
 	cs := make([]Blockchain, 0)
 	for k := range Chains() {
 		cs = append(cs, k)
@@ -53,28 +114,76 @@ func ChainsSlice() []Blockchain {
 }
 
 // "jsonToChains" converts json into chains structure.
-func jsonToChains(b []byte) error {
-	h := Chains()
+// The hosted chains are swapped as a whole, so a reload never exposes a partially applied file.
+// Returns whether the set of hosted blockchains changed, and the chains added or reconfigured.
+func jsonToChains(b []byte) (bool, []Blockchain, error) {
 	data := make([]HostedChain, 0)
-	mux.Lock()
-	defer mux.Unlock()
 	if err := json.Unmarshal(b, &data); err != nil {
-		return err
+		return false, nil, err
 	}
+	h := make(map[Blockchain]HostedChain, len(data))
 	for _, hc := range data {
+		// a malformed pattern would never match, silently relaying the methods it was meant to deny
+		for _, p := range append(append([]string{}, hc.Allow...), hc.Deny...) {
+			if _, err := path.Match(p, ""); err != nil {
+				return false, nil, errors.New(hc.Name + " NetID:" + hc.NetID + " has an invalid method pattern " + p + ": " + err.Error())
+			}
+		}
 		h[hc.Blockchain] = hc
 	}
-	return nil
+	mux.Lock()
+	defer mux.Unlock()
+	old := Chains()
+	changed := len(old) != len(h)
+	var touched []Blockchain
+	for bc, hc := range h {
+		prev, ok := old[bc]
+		if !ok {
+			changed = true
+			touched = append(touched, bc)
+			logs.NewLog(bc.Name+" NetID:"+bc.NetID+" is now hosted", logs.InfoLevel, logs.JSONLogFormat)
+		} else if !reflect.DeepEqual(prev, hc) {
+			touched = append(touched, bc)
+			logs.NewLog(bc.Name+" NetID:"+bc.NetID+" was reconfigured", logs.InfoLevel, logs.JSONLogFormat)
+		}
+	}
+	for bc := range old {
+		if _, ok := h[bc]; !ok {
+			// a chain hosted again later starts over as healthy and synced
+			forgetChain(bc)
+			logs.NewLog(bc.Name+" NetID:"+bc.NetID+" is no longer hosted", logs.InfoLevel, logs.JSONLogFormat)
+		}
+	}
+	chains = h
+	return changed, touched, nil
 }
 
 // "CFile" reads a file into chains.
 func CFile(filepath string) error {
+	_, err := ReloadChains(filepath)
+	return err
+}
+
+// "ReloadChains" reads a file into chains and returns whether the advertised blockchains changed.
+// The chains added or reconfigured are probed (see SetProbe) before returning, so they are only advertised once healthy.
+func ReloadChains(filepath string) (bool, error) {
 	file, err := ioutil.ReadFile(filepath)
 	if err != nil {
 		fmt.Println(err)
-		return err
+		return false, err
+	}
+	changed, touched, err := jsonToChains(file)
+	if err != nil {
+		return false, err
+	}
+	if probe := getProbe(); probe != nil {
+		for _, bc := range touched {
+			if probe(bc) {
+				changed = true
+			}
+		}
 	}
-	return jsonToChains(file)
+	return changed, nil
 }
 
 // "ChainToHosted" returns the hostedChain Object from a blockchain.
@@ -84,36 +193,70 @@ func ChainToHosted(b Blockchain) HostedChain {
 	return Chains()[b]
 }
 
+// "ChainHosted" returns whether the blockchain is hosted by this node.
+func ChainHosted(b Blockchain) bool {
+	mux.Lock()
+	defer mux.Unlock()
+	_, ok := Chains()[b]
+	return ok
+}
+
 // "dialHC" attempts to connect to the specific host:port hosting the chain.
 func dialHC(u *url.URL) error {
-	resp, _ := http.Get(u.String() + u.Path)
+	if u.Scheme == "ws" || u.Scheme == "wss" {
+		conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+	if u.Scheme == "tcp" {
+		conn, err := net.DialTimeout("tcp", u.Host, _const.TCPTIMEOUT*time.Millisecond)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+	resp, err := util.Client(_const.HEALTHTIMEOUT * time.Second).Get(u.String())
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
 	if resp.StatusCode >= 200 {
 		return nil
 	}
 	return errors.New(strconv.Itoa(resp.StatusCode) + " : " + resp.Status)
 }
 
-// "TestChains" tests for hosted blockchain clients.
-func TestChains() {
-	hc := Chains()
-	mux.Lock()
-	defer mux.Unlock()
-	for _, c := range hc {
-		s, err := util.URLProto(c.Host + ":" + c.Port)
-		if err != nil {
-			ExitGracefully(err.Error())
-		}
-		u, err := url.ParseRequestURI(s)
-		if err != nil {
-			ExitGracefully(err.Error())
-		}
-		if c.Path != "" {
-			u.Path = c.Path
+// "DialUpstream" attempts to connect to the upstream of a hosted chain with the medium.
+func DialUpstream(medium string, up Upstream) error {
+	u, err := upstreamURL(medium, up)
+	if err != nil {
+		return err
+	}
+	return dialHC(u)
+}
+
+// "upstreamURL" returns the url of the upstream for the medium.
+func upstreamURL(medium string, up Upstream) (*url.URL, error) {
+	s := up.Host + ":" + up.Port
+	switch medium {
+	case _const.MEDIUMWS, _const.MEDIUMTCP:
+		if !strings.Contains(s, "://") {
+			s = medium + "://" + s
 		}
-		if err := dialHC(u); err != nil {
-			fmt.Fprint(os.Stderr, c.Name+" client is not detected @ "+u.String()+"\n")
-			ExitGracefully(c.Name + " client isn't detected" + "\n")
+	default:
+		var err error
+		if s, err = util.URLProto(s); err != nil {
+			return nil, err
 		}
-		fmt.Println(c.Name + " NetID:" + c.NetID + " client is active and ready for service @ " + u.String())
 	}
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return nil, err
+	}
+	if up.Path != "" {
+		u.Path = up.Path
+	}
+	return u, nil
 }
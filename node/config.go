@@ -2,17 +2,20 @@ package node
 
 import (
 	"fmt"
-	"github.com/pokt-network/pocket-core/const"
+	"os"
 	"time"
 
 	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
 	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/ratelimit"
 	"github.com/pokt-network/pocket-core/util"
 )
 
 const chainsFileExample = "[{\"blockchain\": {\"name\": \"ethereum\",\"netid\": \"1\",\"host\":\"localhost\",\"port\": \"8545\",\"medium\": \"rpc\"},{\"blockchain\": {\"name\": \"bitcoin\",\"netid\": \"1\",\"host\":\"localhost\",\"port\": \"8333\",\"medium\": \"rpc\"}]"
 const devFileExample = "[\"DEVID1\"]"
 const serFileExample = "[\"GID1\"]"
+const limitsFileExample = "[{\"devid\": \"DEVID1\",\"rate\": 10,\"burst\": 20,\"daily\": 100000,\"monthly\": 2000000},{\"devid\": \"DEVID1\",\"blockchain\": \"ethereum\",\"netid\": \"1\",\"rate\": 5,\"burst\": 10}]"
 
 type FileName int
 
@@ -20,6 +23,7 @@ const (
 	ChainFile FileName = iota + 1
 	DeveWL
 	SerWL
+	LimitsFile
 )
 
 func fileErrorMessage(fn FileName) {
@@ -37,6 +41,10 @@ func fileErrorMessage(fn FileName) {
 		path = config.GlobalConfig().SNWL
 		filename = "service white list file"
 		example = serFileExample
+	case LimitsFile:
+		path = config.GlobalConfig().LFile
+		filename = "rate limits"
+		example = limitsFileExample
 	}
 	fmt.Println("There seems to be something wrong with your " + filename + " file @ " + path)
 	fmt.Println("Please ensure that it is in the proper format:")
@@ -57,12 +65,18 @@ func swlConfigFile() error {
 	return nil
 }
 
-func chainsConfigFile() {
-	// chains.json
+// "chainsPath" returns the filepath of chains.json.
+func chainsPath() string {
 	c := config.GlobalConfig().CFile
 	if c == _const.CHAINFILEPLACEHOLDER {
 		c = config.GlobalConfig().DD + _const.FILESEPARATOR + "chains.json"
 	}
+	return c
+}
+
+func chainsConfigFile() {
+	// chains.json
+	c := chainsPath()
 	if err := CFile(c); err != nil {
 		logs.NewLog(err.Error(), logs.WaringLevel, logs.JSONLogFormat)
 		fileErrorMessage(ChainFile)
@@ -81,23 +95,65 @@ func dwlConfigFile() error {
 	return nil
 }
 
+func limitsConfigFile() error {
+	if err := ratelimit.LFile(); err != nil {
+		logs.NewLog(err.Error(), logs.WaringLevel, logs.JSONLogFormat)
+		// the rate limits are optional, only a malformed file is an error
+		if !os.IsNotExist(err) {
+			fileErrorMessage(LimitsFile)
+			return err
+		}
+	}
+	return nil
+}
+
+// "saveQuotas" persists the rate limit quotas to the data directory.
+func saveQuotas() {
+	if err := ratelimit.SaveQuotas(); err != nil {
+		logs.NewLog("unable to write the quota file: "+err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+	}
+}
+
 // "ConfigFiles" configure the client based off of files in the data directory.
 func ConfigFiles() error {
 	chainsConfigFile()
 	WhiteListInit()
+	if err := ratelimit.LoadQuotas(); err != nil {
+		logs.NewLog("unable to read the quota file: "+err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+	}
+	OnExit(saveQuotas)
 	err := dwlConfigFile()
 	err2 := swlConfigFile()
+	err3 := limitsConfigFile()
 	if err != nil {
 		return err
 	}
 	if err2 != nil {
 		return err2
 	}
+	if err3 != nil {
+		return err3
+	}
 	go WLRefresh()
 	return nil
 }
 
-// "WLRefresh" updates data structure in memory from file for both whitelists after a certain amount of time.
+// "reloadChains" applies changes to chains.json, re-registering with the dispatcher if the advertised chains changed.
+// Unlike at startup, a bad file keeps the current chains instead of exiting.
+func reloadChains() {
+	changed, err := ReloadChains(chainsPath())
+	if err != nil {
+		fmt.Println("Error with Hosted Chains " + err.Error())
+		return
+	}
+	if changed && !config.GlobalConfig().Dispatch {
+		if err := Reregister(); err != nil {
+			logs.NewLog("unable to re-register the hosted chains: "+err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		}
+	}
+}
+
+// "WLRefresh" updates data structure in memory from file for the whitelists, the rate limits and the hosted chains after a certain amount of time.
 func WLRefresh() {
 	for {
 		var err error
@@ -107,8 +163,14 @@ func WLRefresh() {
 		}
 		err = swlConfigFile()
 		if err != nil {
-			fmt.Println("Error with Developers WL " + err.Error())
+			fmt.Println("Error with Service WL " + err.Error())
+		}
+		err = limitsConfigFile()
+		if err != nil {
+			fmt.Println("Error with Rate Limits " + err.Error())
 		}
+		saveQuotas()
+		reloadChains()
 		if !config.GlobalConfig().Dispatch {
 			err := UpdateWhiteList()
 			if err != nil {
@@ -2,6 +2,7 @@ package node
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 )
 
@@ -23,10 +24,14 @@ func DispatchPeers() *DPeers {
 	return dp
 }
 
-// "Add" adds a peer to the dispatchPeers structure
+// "Add" adds a peer to the dispatchPeers structure, replacing its previous chains
 func (dp *DPeers) Add(n Node) {
 	dp.Lock()
 	defer dp.Unlock()
+	// a re-registered node may no longer serve some of its chains
+	for _, nodes := range dp.Map {
+		delete(nodes, n.GID)
+	}
 	for _, bchain := range n.Blockchains {
 		// type map[GID]Node
 		nodes := dp.Map[bchain]
@@ -44,23 +49,35 @@ func (dp *DPeers) Add(n Node) {
 }
 
 // "peers" returns a map of peers by blockchain.
-func peers(dp DPeers, bc Blockchain) map[string]Node {
+func peers(dp *DPeers, bc Blockchain) map[string]Node {
 	return dp.Map[bc]
 }
 
 // "PeersByChain" returns a map of peers by blockchain.
-func (dp DPeers) PeersByChain(bc Blockchain) map[string]Node {
+func (dp *DPeers) PeersByChain(bc Blockchain) map[string]Node {
 	dp.Lock()
 	defer dp.Unlock()
 	return peers(dp, bc)
 }
 
+// "NodesByChain" returns a copy of the peers of a blockchain, ordered by GID.
+func (dp *DPeers) NodesByChain(bc Blockchain) []Node {
+	dp.Lock()
+	defer dp.Unlock()
+	nodes := make([]Node, 0, len(dp.Map[bc]))
+	for _, n := range peers(dp, bc) {
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].GID < nodes[j].GID })
+	return nodes
+}
+
 // "Remove" deletes a peer from DPeers.
 func (dp *DPeers) Delete(n Node) {
 	dp.Lock()
 	defer dp.Unlock()
 	for _, chain := range n.Blockchains {
-		delete(peers(*dp, chain), n.GID) // delete node from map via GID
+		delete(peers(dp, chain), n.GID) // delete node from map via GID
 	}
 }
 
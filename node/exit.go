@@ -4,27 +4,51 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sync"
 
 	"github.com/pokt-network/pocket-core/logs"
 )
 
+var (
+	exitHooks []func()
+	exitMux   sync.Mutex
+)
+
+// "OnExit" registers a function to run during the shutdown sequence, e.g. flushing data to disk.
+func OnExit(f func()) {
+	exitMux.Lock()
+	defer exitMux.Unlock()
+	exitHooks = append(exitHooks, f)
+}
+
+// "runExitHooks" runs the registered shutdown functions.
+func runExitHooks() {
+	exitMux.Lock()
+	defer exitMux.Unlock()
+	for _, f := range exitHooks {
+		f()
+	}
+}
+
 // "ExitGracefully" is the shutdown sequece of Pocket Core
 func ExitGracefully(message string) {
 	// unregister from the network
 	if err := UnRegister(0); err != nil {
 		logs.NewLog("Shutting down Pocket Core: "+err.Error(), logs.InfoLevel, logs.JSONLogFormat)
 		fmt.Fprint(os.Stderr, "\nShutting down Pocket Core: "+err.Error())
+		runExitHooks()
 		os.Exit(1)
 	}
 	logs.NewLog("Shutting down Pocket Core: "+message, logs.InfoLevel, logs.JSONLogFormat)
 	fmt.Fprint(os.Stdout, "Shutting down Pocket Core: "+message)
+	runExitHooks()
 	os.Exit(0)
 }
 
 // "WaitForExit" listens for interrupt signal and calls unregister
 func WaitForExit() {
 	// Catches OS system interrupt signal and calls unregister
-	c := make(chan os.Signal)
+	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt)
 	signal.Notify(c, os.Kill)
 	select {
@@ -0,0 +1,72 @@
+package node
+
+import (
+	"sync"
+)
+
+var (
+	unhealthy = make(map[Blockchain]bool) // the hosted chains failing their health checks
+	probe     func(bc Blockchain) bool    // checks the health of a hosted chain, see SetProbe
+	healthMux sync.Mutex
+)
+
+// "SetProbe" sets the health check of the chains added or reconfigured by a reload of chains.json.
+// The probe records the chain's health and returns whether it changed.
+func SetProbe(p func(bc Blockchain) bool) {
+	healthMux.Lock()
+	defer healthMux.Unlock()
+	probe = p
+}
+
+// "getProbe" returns the health check of reloaded chains, nil until the health checks start.
+func getProbe() func(bc Blockchain) bool {
+	healthMux.Lock()
+	defer healthMux.Unlock()
+	return probe
+}
+
+// "SetChainHealth" records the health of the hosted chain and returns whether it changed.
+func SetChainHealth(bc Blockchain, healthy bool) bool {
+	healthMux.Lock()
+	defer healthMux.Unlock()
+	if unhealthy[bc] == !healthy {
+		return false
+	}
+	if healthy {
+		delete(unhealthy, bc)
+	} else {
+		unhealthy[bc] = true
+	}
+	return true
+}
+
+// "ChainHealthy" returns whether the hosted chain passed its last health check (chains are healthy until checked).
+func ChainHealthy(bc Blockchain) bool {
+	healthMux.Lock()
+	defer healthMux.Unlock()
+	return !unhealthy[bc]
+}
+
+// "HealthyChains" returns the hosted chains that passed their last health check, the chains advertised by Self.
+func HealthyChains() []Blockchain {
+	cs := ChainsSlice()
+	healthMux.Lock()
+	defer healthMux.Unlock()
+	healthy := make([]Blockchain, 0, len(cs))
+	for _, bc := range cs {
+		if !unhealthy[bc] {
+			healthy = append(healthy, bc)
+		}
+	}
+	return healthy
+}
+
+// "forgetChain" drops the health and sync status of a chain that is no longer hosted.
+func forgetChain(bc Blockchain) {
+	healthMux.Lock()
+	delete(unhealthy, bc)
+	healthMux.Unlock()
+	syncMux.Lock()
+	delete(syncs, bc)
+	syncMux.Unlock()
+}
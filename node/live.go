@@ -11,26 +11,34 @@ import (
 
 // "Register" marks a service node 'ready for work' in the database.
 func Register() {
+	if err := Reregister(); err != nil {
+		ExitGracefully("error registering node " + err.Error())
+	}
+}
+
+// "Reregister" sends the current node record to the dispatcher, e.g. after its advertised chains changed.
+func Reregister() error {
 	c := config.GlobalConfig()
-	s, err := Self()
+	s, err := NewRegistration(RegisterAction)
 	if err != nil {
-		ExitGracefully("error registering node " + err.Error())
+		return err
 	}
 	u, err := util.URLProto(c.DisIP + ":" + c.DisRPort + "/v1/register")
 	if err != nil {
-		ExitGracefully("error registering node " + err.Error())
+		return err
 	}
 	resp, err := util.StructRPCReq(u, s, util.POST)
 	if err != nil {
-		ExitGracefully("error registering node " + err.Error())
+		return err
 	}
 	fmt.Println(resp)
+	return nil
 }
 
 // "Unregister" removes a service node from the database
 func UnRegister(count int) error {
 	c := config.GlobalConfig()
-	s, err := Self()
+	s, err := NewRegistration(UnRegisterAction)
 	if err != nil {
 		return err
 	}
@@ -40,11 +48,12 @@ func UnRegister(count int) error {
 	}
 	if _, err := util.StructRPCReq(u, s, util.POST); err != nil {
 		fmt.Println("Error, unable to unregister node at Pocket Incorporated's Dispatcher, trying again!")
-		time.Sleep(2)
+		time.Sleep(2 * time.Second)
 		if count > 5 {
 			return errors.New("please contact Pocket Incorporated with this error! As your node was unable to be unregistered")
 		}
-		UnRegister(count + 1)
+		// a retry is signed with a new nonce
+		return UnRegister(count + 1)
 	}
 	return nil
 }
@@ -0,0 +1,34 @@
+package node
+
+import (
+	"github.com/pokt-network/pocket-core/metrics"
+)
+
+func init() {
+	metrics.NewGaugeFunc("pocket_dispatch_peers", "The registered service nodes per blockchain.", dispatchPeerSamples, "blockchain", "netid")
+	metrics.NewGaugeFunc("pocket_whitelist_size", "The entries of the developer and service node whitelists.", whitelistSamples, "list")
+}
+
+// "dispatchPeerSamples" counts the dispatch peers of every blockchain.
+func dispatchPeerSamples() []metrics.Sample {
+	dp := DispatchPeers()
+	dp.Lock()
+	defer dp.Unlock()
+	samples := make([]metrics.Sample, 0, len(dp.Map))
+	for bc, nodes := range dp.Map {
+		samples = append(samples, metrics.Sample{Labels: []string{bc.Name, bc.NetID}, Value: float64(len(nodes))})
+	}
+	return samples
+}
+
+// "whitelistSamples" counts the entries of the whitelists (none before they are loaded).
+func whitelistSamples() []metrics.Sample {
+	samples := make([]metrics.Sample, 0, 2)
+	if DWL() != nil {
+		samples = append(samples, metrics.Sample{Labels: []string{"developer"}, Value: float64(DWL().Count())})
+	}
+	if SWL() != nil {
+		samples = append(samples, metrics.Sample{Labels: []string{"service"}, Value: float64(SWL().Count())})
+	}
+	return samples
+}
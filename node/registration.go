@@ -0,0 +1,135 @@
+package node
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/crypto"
+	"golang.org/x/crypto/ed25519"
+)
+
+// the actions a registration can be signed for
+const (
+	RegisterAction   = "register"
+	UnRegisterAction = "unregister"
+	WhiteListAction  = "whitelist"
+)
+
+// "Registration" is a signed payload of claims about a node.
+// The claims are signed as they were sent and only decoded once the signature is verified,
+// so nodes of different versions (with different Node fields) verify each other's registrations.
+type Registration struct {
+	GID       string `json:"gid"`       // the signer, its public key verifies the signature
+	Payload   []byte `json:"payload"`   // the json encoded claims
+	Signature string `json:"signature"` // hex ed25519 signature of the payload
+}
+
+// "Claims" are the contents of a registration's payload.
+type Claims struct {
+	Node      Node   `json:"node"`
+	Action    string `json:"action"`    // what the signature authorizes (register, unregister, whitelist)
+	Nonce     string `json:"nonce"`     // hex random bytes, rejected if seen before
+	Timestamp int64  `json:"timestamp"` // unix seconds
+}
+
+var (
+	nonces   = make(map[string]time.Time) // <GID:Nonce><expiration>
+	nonceMux sync.Mutex
+)
+
+// "NewRegistration" signs this node for the action with the node key.
+func NewRegistration(action string) (*Registration, error) {
+	self, err := Self()
+	if err != nil {
+		return nil, err
+	}
+	key := config.NodeKey()
+	if key == nil {
+		return nil, errors.New("the node key has not been setup")
+	}
+	nonce, err := crypto.RandBytes(16)
+	if err != nil {
+		return nil, err
+	}
+	r := &Registration{}
+	if err := r.Sign(key, Claims{Node: *self, Action: action, Nonce: hex.EncodeToString(nonce), Timestamp: time.Now().Unix()}); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// "Sign" sets the payload of the registration to the claims, signed with the private key.
+func (r *Registration) Sign(key ed25519.PrivateKey, c Claims) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	r.GID = c.Node.GID
+	r.Payload = b
+	r.Signature = crypto.Sign(key, b)
+	return nil
+}
+
+// "Verify" checks that the registration is signed by a whitelisted key (see EnsureSNWL) for the action,
+// recent and not a replay, and returns the node it was signed for.
+func (r *Registration) Verify(action string) (Node, error) {
+	if GIDPublicKey(r.GID) == "" || !EnsureSNWL(SWL(), r.GID) {
+		return Node{}, errors.New("the registration is not signed by a whitelisted key")
+	}
+	if err := crypto.Verify(GIDPublicKey(r.GID), r.Payload, r.Signature); err != nil {
+		return Node{}, err
+	}
+	c := Claims{}
+	if err := json.Unmarshal(r.Payload, &c); err != nil {
+		return Node{}, err
+	}
+	if c.Node.GID != r.GID {
+		return Node{}, errors.New("the registration is signed for another node")
+	}
+	if c.Action != action {
+		return Node{}, errors.New("the registration is not signed for " + action)
+	}
+	window := int64(_const.REGISTRATIONWINDOW)
+	if now := time.Now().Unix(); c.Timestamp < now-window || c.Timestamp > now+window {
+		return Node{}, errors.New("the registration timestamp is outside of the accepted window")
+	}
+	if c.Nonce == "" {
+		return Node{}, errors.New("the registration has no nonce")
+	}
+	if err := useNonce(r.GID+":"+c.Nonce, time.Unix(c.Timestamp+window, 0)); err != nil {
+		return Node{}, err
+	}
+	return c.Node, nil
+}
+
+// "useNonce" records the nonce until it expires, failing if it has been used already.
+func useNonce(n string, expiration time.Time) error {
+	nonceMux.Lock()
+	defer nonceMux.Unlock()
+	now := time.Now()
+	for k, exp := range nonces {
+		if exp.Before(now) {
+			delete(nonces, k)
+		}
+	}
+	if _, ok := nonces[n]; ok {
+		return errors.New("the registration has already been used")
+	}
+	nonces[n] = expiration
+	return nil
+}
+
+// "GIDPublicKey" returns the hex encoded public key a GID is derived from. ->
+// GID prefix + ":" + hex(public key)
+func GIDPublicKey(gid string) string {
+	if index := strings.IndexByte(gid, ':'); index > 0 {
+		return gid[index+1:]
+	}
+	return ""
+}
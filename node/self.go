@@ -38,11 +38,14 @@ func Self() (*Node, error) {
 			ExitGracefully("unable to generate GID " + err.Error())
 		}
 		self = &Node{GID: config.GlobalConfig().GID, RelayPort: config.GlobalConfig().Port, // notice this change
-			IP: ip, Blockchains: ChainsSlice(),
-			ClientID: _const.CLIENTID, CliVersion: _const.VERSION}
+			IP: ip, ClientID: _const.CLIENTID, CliVersion: _const.VERSION}
 	})
 	if err != nil {
 		return nil, err
 	}
-	return self, nil
+	// the advertised chains change with their health and sync, so every call gets a copy with the current ones
+	s := *self
+	s.Blockchains = HealthyChains()
+	s.Sync = SyncStatuses()
+	return &s, nil
 }
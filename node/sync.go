@@ -0,0 +1,60 @@
+package node
+
+import (
+	"sort"
+	"sync"
+)
+
+// "SyncStatus" is the head of a hosted chain compared to its reference.
+type SyncStatus struct {
+	Blockchain
+	Height    uint64 `json:"height"`              // the latest block of the hosted chain
+	Reference uint64 `json:"reference,omitempty"` // the latest block of the chain's reference client (0 if not configured)
+	Synced    bool   `json:"synced"`              // whether the hosted chain is within the sync lag of its reference
+}
+
+var (
+	syncs   = make(map[Blockchain]SyncStatus)
+	syncMux sync.Mutex
+)
+
+// "SetSyncStatus" records the sync status of a hosted chain.
+func SetSyncStatus(s SyncStatus) {
+	syncMux.Lock()
+	defer syncMux.Unlock()
+	syncs[s.Blockchain] = s
+}
+
+// "SyncStatuses" returns the sync status of the hosted chains, ordered by name and netid.
+func SyncStatuses() []SyncStatus {
+	syncMux.Lock()
+	defer syncMux.Unlock()
+	ss := make([]SyncStatus, 0, len(syncs))
+	for _, s := range syncs {
+		ss = append(ss, s)
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		if ss[i].Name != ss[j].Name {
+			return ss[i].Name < ss[j].Name
+		}
+		return ss[i].NetID < ss[j].NetID
+	})
+	return ss
+}
+
+// "ChainHeight" returns the latest block of the hosted chain from its last health check, 0 if unknown.
+func ChainHeight(bc Blockchain) uint64 {
+	syncMux.Lock()
+	defer syncMux.Unlock()
+	return syncs[bc].Height
+}
+
+// "ChainSynced" returns whether the hosted chain is within the sync lag of its reference (chains are synced until checked).
+func ChainSynced(bc Blockchain) bool {
+	syncMux.Lock()
+	defer syncMux.Unlock()
+	if s, ok := syncs[bc]; ok {
+		return s.Synced
+	}
+	return true
+}
@@ -0,0 +1,105 @@
+package node
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/const"
+)
+
+// "Upstream" is one of the client endpoints of a hosted chain.
+type Upstream struct {
+	Host     string `json:"host"`
+	Port     string `json:"port"`
+	Path     string `json:"path"`
+	Priority int    `json:"priority"` // lower priorities are tried first
+	Weight   int    `json:"weight"`   // the share of relays among the upstreams of the same priority (1 if not set)
+}
+
+// "upstreamState" holds the consecutive failures of an upstream.
+type upstreamState struct {
+	failures  int
+	downUntil time.Time // out of rotation until
+}
+
+var (
+	upstreams   = make(map[string]*upstreamState) // <host:port/path><state>
+	upstreamMux sync.Mutex
+)
+
+// "key" identifies the upstream.
+func (u Upstream) key() string {
+	return u.Host + ":" + u.Port + u.Path
+}
+
+// "Endpoints" returns the upstreams of the hosted chain in the order they should be tried. ->
+// By priority, weighted random within a priority, upstreams out of rotation last.
+// A hosted chain without upstreams has a single one, its own host, port and path.
+func (hc HostedChain) Endpoints() []Upstream {
+	if len(hc.Upstreams) == 0 {
+		return []Upstream{{Host: hc.Host, Port: hc.Port, Path: hc.Path}}
+	}
+	ups := make([]Upstream, len(hc.Upstreams))
+	copy(ups, hc.Upstreams)
+	// exponential random keys divided by the weight, so the smallest is chosen proportionally to its weight
+	order := make(map[string]float64, len(ups))
+	for _, u := range ups {
+		w := u.Weight
+		if w <= 0 {
+			w = 1
+		}
+		order[u.key()] = rand.ExpFloat64() / float64(w)
+	}
+	upstreamMux.Lock()
+	now := time.Now()
+	down := make(map[string]bool, len(ups))
+	for _, u := range ups {
+		if s, ok := upstreams[u.key()]; ok && s.downUntil.After(now) {
+			down[u.key()] = true
+		}
+	}
+	upstreamMux.Unlock()
+	sort.SliceStable(ups, func(i, j int) bool {
+		a, b := ups[i], ups[j]
+		if down[a.key()] != down[b.key()] {
+			return !down[a.key()]
+		}
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return order[a.key()] < order[b.key()]
+	})
+	return ups
+}
+
+// "At" returns the hosted chain pointed at the upstream.
+func (hc HostedChain) At(u Upstream) HostedChain {
+	hc.Host, hc.Port, hc.Path = u.Host, u.Port, u.Path
+	hc.Upstreams = nil
+	return hc
+}
+
+// "ReportUpstream" records the outcome of a relay to the upstream. ->
+// After repeated consecutive failures the upstream is taken out of rotation for a while.
+func ReportUpstream(u Upstream, ok bool) {
+	upstreamMux.Lock()
+	defer upstreamMux.Unlock()
+	s, found := upstreams[u.key()]
+	if ok {
+		if found {
+			delete(upstreams, u.key())
+		}
+		return
+	}
+	if !found {
+		s = &upstreamState{}
+		upstreams[u.key()] = s
+	}
+	s.failures++
+	if s.failures >= _const.UPSTREAMFAILURES {
+		s.failures = 0
+		s.downUntil = time.Now().Add(_const.UPSTREAMCOOLDOWN * time.Second)
+	}
+}
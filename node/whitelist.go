@@ -8,7 +8,6 @@ import (
 	"github.com/pokt-network/pocket-core/util"
 	"io/ioutil"
 	"os"
-	"strings"
 	"sync"
 
 	"github.com/pokt-network/pocket-core/config"
@@ -158,25 +157,21 @@ func getWhiteList() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	pl, err := Self()
+	r, err := NewRegistration(WhiteListAction)
 	if err != nil {
 		return "", err
 	}
-	return util.StructRPCReq(url, pl, util.POST)
+	return util.StructRPCReq(url, r, util.POST)
 }
 
-// "EnsureSNWL" cross checks the whitelist for
-func EnsureSNWL(whiteList *Whitelist, query string) bool {
-	if index := strings.IndexByte(query, ':'); index > 0 { // delimited by ':'
-		query = query[:index]
-	}
-	if !whiteList.Contains(query) {
-		os.Stderr.WriteString("Node: " + query + " rejected because it is not within whitelist. Code: 1\n")
+// "EnsureSNWL" checks that the GID, or the public key it is derived from, is within the service node whitelist.
+// The prefix of a GID is chosen by the node, so it never authorizes a node on its own.
+func EnsureSNWL(whiteList *Whitelist, gid string) bool {
+	if whiteList == nil || !(whiteList.Contains(gid) || whiteList.Contains(GIDPublicKey(gid))) {
+		os.Stderr.WriteString("Node: " + gid + " rejected because it is not within whitelist. Code: 1\n")
 		return false
 	}
 	return true
-	os.Stderr.WriteString("Node: " + query + " rejected because it is not within whitelist. Code: 2\n")
-	return false
 }
 
 func EnsureDWL(whiteList *Whitelist, query string) bool {
@@ -193,6 +188,4 @@ func EnsureDWL(whiteList *Whitelist, query string) bool {
 		}
 	}
 	return true
-	os.Stderr.WriteString("Node: " + query + " rejected because it is not within whitelist. Code: 2\n")
-	return false
 }
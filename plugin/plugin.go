@@ -0,0 +1,107 @@
+// This package is the registry of the relay mediums, the plugins that forward relays to hosted chains.
+package plugin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/node"
+)
+
+// "Relay" is a JSON structure that specifies information to complete reads and writes to other blockchains
+type Relay struct {
+	Blockchain string            `json:"blockchain"`
+	NetworkID  string            `json:"netid"`
+	Data       string            `json:"data"`
+	DevID      string            `json:"devid"`
+	Method     string            `json:"method,omitempty"`  // the http method of a rest relay (GET if not set)
+	Path       string            `json:"path,omitempty"`    // the url path of a rest relay, appended to the hosted chain's path
+	Query      string            `json:"query,omitempty"`   // the raw url query of a rest relay
+	Headers    map[string]string `json:"headers,omitempty"` // the http headers of a rest relay
+}
+
+// "Response" is the response of a hosted chain to a relay.
+type Response struct {
+	Code int    `json:"code"` // the status code of the hosted chain (200 for mediums without status codes)
+	Body string `json:"body"`
+}
+
+// "Plugin" forwards relays to hosted chains over a medium.
+type Plugin interface {
+	// "Execute" forwards the relay to the hosted chain and returns its response.
+	Execute(ctx context.Context, relay Relay, hc node.HostedChain) (Response, error)
+}
+
+var (
+	plugins = make(map[string]Plugin) // <medium><plugin>
+	mux     sync.RWMutex
+)
+
+// "Register" adds the plugin of the medium, replacing any previous one.
+func Register(medium string, p Plugin) {
+	mux.Lock()
+	defer mux.Unlock()
+	plugins[medium] = p
+}
+
+// "Get" returns the plugin of the medium, a hosted chain without a medium uses rpc.
+func Get(medium string) (Plugin, error) {
+	if medium == "" {
+		medium = _const.MEDIUMRPC
+	}
+	mux.RLock()
+	defer mux.RUnlock()
+	p, ok := plugins[medium]
+	if !ok {
+		return nil, errors.New("unsupported relay medium: " + medium)
+	}
+	return p, nil
+}
+
+// "Mediums" returns the names of the registered mediums, sorted.
+func Mediums() []string {
+	mux.RLock()
+	defer mux.RUnlock()
+	m := make([]string, 0, len(plugins))
+	for k := range plugins {
+		m = append(m, k)
+	}
+	sort.Strings(m)
+	return m
+}
+
+// "Execute" forwards the relay through the plugin of the hosted chain's medium.
+func Execute(ctx context.Context, relay Relay, hc node.HostedChain) (Response, error) {
+	p, err := Get(hc.Medium)
+	if err != nil {
+		return Response{}, err
+	}
+	resp, err := p.Execute(ctx, relay, hc)
+	if resp.Code == 0 {
+		resp.Code = http.StatusOK
+	}
+	return resp, err
+}
+
+// "OnCancel" calls f if the context is done before stop is called, e.g. to interrupt a blocking read with a deadline.
+// Stop returns once f can no longer be called.
+func OnCancel(ctx context.Context, f func()) (stop func()) {
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		select {
+		case <-ctx.Done():
+			f()
+		case <-done:
+		}
+	}()
+	return func() {
+		close(done)
+		<-exited
+	}
+}
@@ -0,0 +1,84 @@
+// The relay forwarding plugin for rest medium
+package rest
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/plugin"
+	"github.com/pokt-network/pocket-core/util"
+)
+
+// "Plugin" forwards relays as http requests with the relay's method, path, query and headers.
+type Plugin struct{}
+
+func init() {
+	plugin.Register(_const.MEDIUMREST, Plugin{})
+}
+
+// "Execute" sends the relay to the hosted chain and returns the response with its status code.
+func (Plugin) Execute(ctx context.Context, relay plugin.Relay, hc node.HostedChain) (plugin.Response, error) {
+	u, err := URL(hc, relay)
+	if err != nil {
+		return plugin.Response{}, err
+	}
+	method := strings.ToUpper(relay.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequest(method, u.String(), strings.NewReader(relay.Data))
+	if err != nil {
+		return plugin.Response{}, err
+	}
+	req = req.WithContext(ctx)
+	for k, v := range relay.Headers {
+		req.Header.Set(k, v)
+	}
+	if id := logs.RequestID(ctx); id != "" {
+		req.Header.Set(_const.REQUESTIDHEADER, id)
+	}
+	if h, ok := relay.Headers["Host"]; ok {
+		req.Host = h
+	}
+	resp, err := util.Client(0).Do(req)
+	if err != nil {
+		util.ForgetScheme(u.Host)
+		return plugin.Response{}, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return plugin.Response{}, err
+	}
+	return plugin.Response{Code: resp.StatusCode, Body: string(b)}, nil
+}
+
+// "URL" returns the url of the relay: the hosted chain's address and path, followed by the relay's path and query.
+// The relay's path is cleaned, so it can't escape the hosted chain's path (e.g. with "..").
+func URL(hc node.HostedChain, relay plugin.Relay) (*url.URL, error) {
+	s, err := util.URLProto(hc.Host + ":" + hc.Port)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = strings.TrimSuffix(hc.Path, "/") + path.Clean("/"+relay.Path)
+	if strings.HasSuffix(relay.Path, "/") && !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	query := strings.TrimPrefix(relay.Query, "?")
+	if _, err := url.ParseQuery(query); err != nil {
+		return nil, err
+	}
+	u.RawQuery = query
+	return u, nil
+}
@@ -3,33 +3,64 @@ package rpc
 
 import (
 	"bytes"
+	"context"
 	"errors"
-	"fmt"
-	"github.com/pokt-network/pocket-core/util"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/plugin"
+	"github.com/pokt-network/pocket-core/util"
 )
 
-// "ExecuteRequest" takes in the raw json string and forwards it to the port
-func ExecuteRequest(jsonStr []byte, u *url.URL) (string, error) {
-	ur, err := util.URLProto(u.String()+u.Path)
+// "Plugin" forwards relays as http POST requests.
+type Plugin struct{}
+
+func init() {
+	plugin.Register(_const.MEDIUMRPC, Plugin{})
+}
+
+// "Execute" posts the relay data to the hosted chain.
+func (Plugin) Execute(ctx context.Context, relay plugin.Relay, hc node.HostedChain) (plugin.Response, error) {
+	s, err := util.URLProto(hc.Host + ":" + hc.Port)
+	if err != nil {
+		return plugin.Response{}, err
+	}
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return plugin.Response{}, err
+	}
+	if hc.Path != "" {
+		u.Path = hc.Path
+	}
+	body, code, err := ExecuteRequest(ctx, []byte(relay.Data), u)
+	return plugin.Response{Code: code, Body: body}, err
+}
+
+// "ExecuteRequest" takes in the raw json string and forwards it to the port, returning the response and its status code.
+// The connection is kept alive by the shared transport, the request is bound by the context.
+func ExecuteRequest(ctx context.Context, jsonStr []byte, u *url.URL) (string, int, error) {
+	req, err := http.NewRequest("POST", u.String(), bytes.NewBuffer(jsonStr))
 	if err != nil {
-		return "", err
+		return "", 0, err
 	}
-	req, err := http.NewRequest("POST", ur, bytes.NewBuffer(jsonStr))
-	req.Close = true
+	req = req.WithContext(ctx)
 	req.Header.Set("Content-Type", "application/json")
-	resp, err := (&http.Client{}).Do(req)
+	if id := logs.RequestID(ctx); id != "" {
+		req.Header.Set(_const.REQUESTIDHEADER, id)
+	}
+	resp, err := util.Client(0).Do(req)
 	if err != nil {
-		return "", err
+		util.ForgetScheme(u.Host)
+		return "", 0, err
 	}
 	if resp == nil {
-		return "", errors.New("500: no response error")
+		return "", 0, errors.New("500: no response error")
 	}
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
-	fmt.Println(resp.Status)
-	fmt.Println(resp)
-	return string(body), nil
+	return string(body), resp.StatusCode, nil
 }
@@ -0,0 +1,58 @@
+package tcp
+
+import (
+	"bufio"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/const"
+)
+
+// "conn" is a tcp connection with its line reader, which must outlive a single request.
+type conn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+// "pool" keeps idle tcp connections per hosted chain address.
+type pool struct {
+	idle map[string][]*conn // <address><idle connections>
+	sync.Mutex
+}
+
+var conns = &pool{idle: make(map[string][]*conn)}
+
+// "get" returns an idle connection to the address, dialing a new one if there is none.
+func (p *pool) get(addr string, timeout time.Duration) (c *conn, pooled bool, err error) {
+	p.Lock()
+	if idle := p.idle[addr]; len(idle) > 0 {
+		c = idle[len(idle)-1]
+		p.idle[addr] = idle[:len(idle)-1]
+		p.Unlock()
+		return c, true, nil
+	}
+	p.Unlock()
+	c, err = dial(addr, timeout)
+	return c, false, err
+}
+
+// "put" returns the connection to the pool, closing it if the pool is full.
+func (p *pool) put(addr string, c *conn) {
+	p.Lock()
+	defer p.Unlock()
+	if len(p.idle[addr]) >= _const.TCPPOOLSIZE {
+		c.Close()
+		return
+	}
+	p.idle[addr] = append(p.idle[addr], c)
+}
+
+// "dial" opens a new connection to the address.
+func dial(addr string, timeout time.Duration) (*conn, error) {
+	nc, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return &conn{Conn: nc, r: bufio.NewReader(nc)}, nil
+}
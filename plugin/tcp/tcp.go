@@ -0,0 +1,139 @@
+// The relay forwarding plugin for tcp medium (newline delimited json rpc)
+package tcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/plugin"
+)
+
+// "Plugin" forwards relays as newline delimited json rpc over pooled tcp connections.
+type Plugin struct{}
+
+func init() {
+	plugin.Register(_const.MEDIUMTCP, Plugin{})
+}
+
+// "rpcMessage" holds the id of a json rpc message, to correlate the response with its request,
+// and the method of notifications, which are never responses.
+type rpcMessage struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+// "Execute" sends the relay data to the hosted chain and returns the response.
+func (Plugin) Execute(ctx context.Context, relay plugin.Relay, hc node.HostedChain) (plugin.Response, error) {
+	timeout := time.Duration(hc.Timeout) * time.Millisecond
+	if hc.Timeout <= 0 {
+		timeout = _const.TCPTIMEOUT * time.Millisecond
+	}
+	body, err := ExecuteRequest(ctx, []byte(relay.Data), Address(hc.Host, hc.Port), timeout)
+	return plugin.Response{Code: http.StatusOK, Body: body}, err
+}
+
+// "Address" returns the host:port of the hosted chain (without a tcp:// scheme).
+func Address(host, port string) string {
+	return net.JoinHostPort(strings.TrimPrefix(host, "tcp://"), port)
+}
+
+// "ExecuteRequest" writes the json as a single line on a pooled connection and returns the response line with the same id.
+func ExecuteRequest(ctx context.Context, jsonStr []byte, addr string, timeout time.Duration) (string, error) {
+	// compacting guarantees the request is a single line
+	msg := &bytes.Buffer{}
+	if err := json.Compact(msg, jsonStr); err != nil {
+		return "", err
+	}
+	msg.WriteByte('\n')
+	req := rpcMessage{}
+	json.Unmarshal(msg.Bytes(), &req) // batches have no id, the first line that isn't a notification is the response
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	c, pooled, err := conns.get(addr, timeout)
+	if err != nil {
+		return "", err
+	}
+	resp, stale, err := cancelableRoundTrip(ctx, c, msg.Bytes(), req.ID, deadline)
+	// an idle connection may have been closed by the hosted chain, retry once on a new one.
+	// Only if the request never reached the hosted chain, so it is never executed twice (e.g. a broadcast).
+	if err != nil && pooled && stale && ctx.Err() == nil && time.Now().Before(deadline) {
+		c.Close()
+		if c, err = dial(addr, timeout); err != nil {
+			return "", err
+		}
+		resp, _, err = cancelableRoundTrip(ctx, c, msg.Bytes(), req.ID, deadline)
+	}
+	if err != nil {
+		c.Close()
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", err
+	}
+	conns.put(addr, c)
+	return string(resp), nil
+}
+
+// "cancelableRoundTrip" is a round trip that is interrupted once the context is done.
+func cancelableRoundTrip(ctx context.Context, c *conn, msg []byte, id json.RawMessage, deadline time.Time) ([]byte, bool, error) {
+	stop := plugin.OnCancel(ctx, func() { c.SetDeadline(time.Now()) })
+	defer stop()
+	return roundTrip(c, msg, id, deadline)
+}
+
+// "roundTrip" writes the message and reads lines until the response with the same id.
+// On error, stale reports whether the connection was found closed before the hosted chain could have answered:
+// the write failed or the connection was closed before any byte was read.
+func roundTrip(c *conn, msg []byte, id json.RawMessage, deadline time.Time) (resp []byte, stale bool, err error) {
+	c.SetDeadline(deadline)
+	defer c.SetDeadline(time.Time{})
+	if _, err := c.Write(msg); err != nil {
+		return nil, true, err
+	}
+	read := false
+	for {
+		line, err := c.r.ReadBytes('\n')
+		if err != nil {
+			return nil, !read && len(line) == 0 && closedConn(err), err
+		}
+		read = true
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		m := rpcMessage{}
+		json.Unmarshal(line, &m) // a batch response is an array
+		// a notification (e.g. of a subscription), skip it
+		if m.Method != "" {
+			continue
+		}
+		if len(id) == 0 || bytes.Equal(bytes.TrimSpace(m.ID), bytes.TrimSpace(id)) {
+			return line, false, nil
+		}
+	}
+}
+
+// "closedConn" returns whether the read error is the connection being closed (or reset) by the hosted chain.
+func closedConn(err error) bool {
+	if err == io.EOF {
+		return true
+	}
+	if op, ok := err.(*net.OpError); ok {
+		if se, ok := op.Err.(*os.SyscallError); ok {
+			return se.Err == syscall.ECONNRESET
+		}
+	}
+	return false
+}
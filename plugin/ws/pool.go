@@ -0,0 +1,41 @@
+package ws
+
+import (
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/pokt-network/pocket-core/const"
+)
+
+// "pool" keeps idle websocket connections per hosted chain url.
+type pool struct {
+	idle map[string][]*websocket.Conn // <url><idle connections>
+	sync.Mutex
+}
+
+var conns = &pool{idle: make(map[string][]*websocket.Conn)}
+
+// "get" returns an idle connection to the url, dialing a new one if there is none.
+func (p *pool) get(u string) (conn *websocket.Conn, pooled bool, err error) {
+	p.Lock()
+	if idle := p.idle[u]; len(idle) > 0 {
+		conn = idle[len(idle)-1]
+		p.idle[u] = idle[:len(idle)-1]
+		p.Unlock()
+		return conn, true, nil
+	}
+	p.Unlock()
+	conn, _, err = websocket.DefaultDialer.Dial(u, nil)
+	return conn, false, err
+}
+
+// "put" returns the connection to the pool, closing it if the pool is full.
+func (p *pool) put(u string, conn *websocket.Conn) {
+	p.Lock()
+	defer p.Unlock()
+	if len(p.idle[u]) >= _const.WSPOOLSIZE {
+		conn.Close()
+		return
+	}
+	p.idle[u] = append(p.idle[u], conn)
+}
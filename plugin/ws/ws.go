@@ -0,0 +1,227 @@
+// The relay forwarding plugin for ws medium
+package ws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/plugin"
+)
+
+// "Plugin" forwards relays over pooled websocket connections.
+type Plugin struct{}
+
+func init() {
+	plugin.Register(_const.MEDIUMWS, Plugin{})
+}
+
+// "Execute" sends the relay data to the hosted chain and returns the response.
+func (Plugin) Execute(ctx context.Context, relay plugin.Relay, hc node.HostedChain) (plugin.Response, error) {
+	u, err := URL(hc.Host, hc.Port, hc.Path)
+	if err != nil {
+		return plugin.Response{}, err
+	}
+	body, err := ExecuteRequest(ctx, []byte(relay.Data), u)
+	return plugin.Response{Code: http.StatusOK, Body: body}, err
+}
+
+// "rpcMessage" holds the fields of a json rpc message needed to route it.
+type rpcMessage struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+// "URL" returns the websocket url of the hosted chain (ws:// unless the host specifies a scheme).
+func URL(host, port, path string) (*url.URL, error) {
+	if !strings.Contains(host, "://") {
+		host = "ws://" + host
+	}
+	u, err := url.ParseRequestURI(host + ":" + port)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		u.Path = path
+	}
+	return u, nil
+}
+
+// "ExecuteRequest" sends the raw json over a pooled connection and returns the response.
+// Subscriptions are answered with their id, but the connection is not reused as it would receive the notifications.
+// The request is interrupted once the context is done.
+func ExecuteRequest(ctx context.Context, jsonStr []byte, u *url.URL) (string, error) {
+	req := rpcMessage{}
+	json.Unmarshal(jsonStr, &req) // not json rpc, the first message is the response
+	conn, pooled, err := conns.get(u.String())
+	if err != nil {
+		return "", err
+	}
+	deadline := time.Now().Add(_const.WSTIMEOUT * time.Second)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	resp, stale, err := cancelableRoundTrip(ctx, conn, jsonStr, req.ID, deadline)
+	// an idle connection may have been closed by the hosted chain, retry once on a new one.
+	// Only if the request never reached the hosted chain, so it is never executed twice (e.g. a broadcast).
+	if err != nil && pooled && stale && ctx.Err() == nil && time.Now().Before(deadline) {
+		conn.Close()
+		if conn, _, err = websocket.DefaultDialer.DialContext(ctx, u.String(), nil); err != nil {
+			return "", err
+		}
+		resp, _, err = cancelableRoundTrip(ctx, conn, jsonStr, req.ID, deadline)
+	}
+	if err != nil {
+		conn.Close()
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", err
+	}
+	if IsSubscription(req.Method) {
+		conn.Close()
+	} else {
+		conns.put(u.String(), conn)
+	}
+	return string(resp), nil
+}
+
+// "cancelableRoundTrip" is a round trip that is interrupted once the context is done.
+func cancelableRoundTrip(ctx context.Context, conn *websocket.Conn, msg []byte, id json.RawMessage, deadline time.Time) ([]byte, bool, error) {
+	stop := plugin.OnCancel(ctx, func() { conn.UnderlyingConn().SetDeadline(time.Now()) })
+	defer stop()
+	return roundTrip(conn, msg, id, deadline)
+}
+
+// "roundTrip" writes the message and reads until the response with the same id.
+// On error, stale reports whether the connection was found closed before the hosted chain could have answered:
+// the write failed or the connection was closed before any message was read.
+func roundTrip(conn *websocket.Conn, msg []byte, id json.RawMessage, deadline time.Time) (resp []byte, stale bool, err error) {
+	conn.SetWriteDeadline(deadline)
+	if err := conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+		return nil, true, err
+	}
+	conn.SetReadDeadline(deadline)
+	defer conn.SetReadDeadline(time.Time{})
+	read := false
+	for {
+		_, resp, err := conn.ReadMessage()
+		if err != nil {
+			return nil, !read && closedConn(err), err
+		}
+		read = true
+		if len(id) == 0 {
+			return resp, false, nil
+		}
+		m := rpcMessage{}
+		if err := json.Unmarshal(resp, &m); err == nil && sameID(m.ID, id) {
+			return resp, false, nil
+		}
+		// a notification of an earlier subscription, skip it
+	}
+}
+
+// "closedConn" returns whether the read error is the connection being closed (or reset) by the hosted chain.
+func closedConn(err error) bool {
+	if _, ok := err.(*websocket.CloseError); ok {
+		return true
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+	if op, ok := err.(*net.OpError); ok {
+		if se, ok := op.Err.(*os.SyscallError); ok {
+			return se.Err == syscall.ECONNRESET
+		}
+	}
+	return false
+}
+
+// "sameID" compares two json rpc ids ignoring whitespace.
+func sameID(a, b json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(a), bytes.TrimSpace(b))
+}
+
+// "IsSubscription" returns whether the json rpc method opens a subscription (e.g. eth_subscribe).
+func IsSubscription(method string) bool {
+	return strings.HasSuffix(method, "_subscribe")
+}
+
+// "Dial" opens a dedicated connection to the hosted chain, for subscriptions that stream notifications.
+func Dial(u *url.URL) (*websocket.Conn, error) {
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	return conn, err
+}
+
+// "Stream" copies messages between the developer and the hosted chain until either side closes.
+// Every developer message is passed to onRequest first, a non nil error is written back instead of forwarding it.
+// Every hosted chain message is passed to onResponse.
+func Stream(dev, chain *websocket.Conn, onRequest func(msg []byte) error, onResponse func(msg []byte)) error {
+	errc := make(chan error, 2)
+	// both directions write to the developer, a connection supports one writer at a time
+	var devMux sync.Mutex
+	writeDev := func(t int, msg []byte) error {
+		devMux.Lock()
+		defer devMux.Unlock()
+		return dev.WriteMessage(t, msg)
+	}
+	// hosted chain -> developer
+	go func() {
+		for {
+			t, msg, err := chain.ReadMessage()
+			if err != nil {
+				errc <- err
+				return
+			}
+			onResponse(msg)
+			if err := writeDev(t, msg); err != nil {
+				errc <- err
+				return
+			}
+		}
+	}()
+	// developer -> hosted chain
+	go func() {
+		for {
+			t, msg, err := dev.ReadMessage()
+			if err != nil {
+				errc <- err
+				return
+			}
+			if err := onRequest(msg); err != nil {
+				b, _ := json.Marshal(struct {
+					Error string `json:"error"`
+				}{err.Error()})
+				if err := writeDev(websocket.TextMessage, b); err != nil {
+					errc <- err
+					return
+				}
+				continue
+			}
+			if err := chain.WriteMessage(t, msg); err != nil {
+				errc <- err
+				return
+			}
+		}
+	}()
+	err := <-errc
+	// unblock the other direction
+	dev.Close()
+	chain.Close()
+	if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,201 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
+)
+
+// "Chain" is the blockchain a request is made for.
+type Chain struct {
+	Blockchain string
+	NetID      string
+}
+
+// "bucket" is a token bucket of a rate limit.
+type bucket struct {
+	limit  string // the key of the limit
+	tokens float64
+	last   time.Time
+}
+
+// "quota" counts the requests of the current UTC day and month.
+type quota struct {
+	Limit   string `json:"limit"` // the key of the limit
+	Day     string `json:"day"`
+	Daily   int64  `json:"daily"`
+	Month   string `json:"month"`
+	Monthly int64  `json:"monthly"`
+}
+
+var (
+	buckets  = make(map[string]*bucket) // <Limit key|DevID><bucket>
+	quotas   = make(map[string]*quota)  // <Limit key|DevID><quota>
+	stateMux sync.Mutex
+)
+
+// "Error" is returned for requests over one of their limits.
+type Error struct {
+	RetryAfter time.Duration // how long until the request would be allowed
+}
+
+func (e *Error) Error() string {
+	return "Rate limit exceeded, retry after " + strconv.Itoa(int(math.Ceil(e.RetryAfter.Seconds()))) + " seconds"
+}
+
+// "Check" consumes one request of every limit that applies like Allow, returning an *Error if any is exceeded.
+// Requests are checked once the developer is known to be whitelisted, so unknown developers never create state.
+func Check(devID string, chains ...Chain) error {
+	if ok, retry := Allow(devID, chains...); !ok {
+		return &Error{RetryAfter: retry}
+	}
+	return nil
+}
+
+// "Allow" consumes one request of every limit that applies, or none of them if any is exceeded.
+// When the request is not allowed, the returned duration is how long until it would be.
+func Allow(devID string, chains ...Chain) (bool, time.Duration) {
+	ls := applicable(devID, chains)
+	if len(ls) == 0 {
+		return true, 0
+	}
+	now := time.Now().UTC()
+	day, month := now.Format("2006-01-02"), now.Format("2006-01")
+	stateMux.Lock()
+	defer stateMux.Unlock()
+	var retry time.Duration
+	for _, l := range ls {
+		if wait := exceeded(l, devID, now, day, month); wait > retry {
+			retry = wait
+		}
+	}
+	if retry > 0 {
+		return false, retry
+	}
+	for _, l := range ls {
+		if l.Rate > 0 {
+			buckets[stateKey(l, devID)].tokens--
+		}
+		q := quotas[stateKey(l, devID)]
+		q.Daily++
+		q.Monthly++
+	}
+	return true, 0
+}
+
+// "stateKey" identifies the bucket and quota of a developer, as limits for any developer are not shared.
+func stateKey(l Limit, devID string) string {
+	return l.key() + "|" + devID
+}
+
+// "exceeded" refills the limit's state and returns how long until a request is allowed, 0 if it is now.
+func exceeded(l Limit, devID string, now time.Time, day string, month string) time.Duration {
+	var wait time.Duration
+	key := stateKey(l, devID)
+	if l.Rate > 0 {
+		burst := math.Max(float64(l.Burst), 1)
+		b, ok := buckets[key]
+		if !ok {
+			b = &bucket{limit: l.key(), tokens: burst, last: now}
+			buckets[key] = b
+		}
+		b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*l.Rate)
+		b.last = now
+		if b.tokens < 1 {
+			wait = time.Duration((1 - b.tokens) / l.Rate * float64(time.Second))
+		}
+	}
+	q, ok := quotas[key]
+	if !ok {
+		q = &quota{Limit: l.key()}
+		quotas[key] = q
+	}
+	if q.Day != day {
+		q.Day, q.Daily = day, 0
+	}
+	if q.Month != month {
+		q.Month, q.Monthly = month, 0
+	}
+	if l.Daily > 0 && q.Daily >= l.Daily {
+		tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+		wait = maxDuration(wait, tomorrow.Sub(now))
+	}
+	if l.Monthly > 0 && q.Monthly >= l.Monthly {
+		nextMonth := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		wait = maxDuration(wait, nextMonth.Sub(now))
+	}
+	return wait
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// "pruneState" forgets the buckets and quotas of limits that no longer exist.
+func pruneState(data []Limit) {
+	keys := make(map[string]struct{})
+	for _, l := range data {
+		keys[l.key()] = struct{}{}
+	}
+	stateMux.Lock()
+	defer stateMux.Unlock()
+	for k, b := range buckets {
+		if _, ok := keys[b.limit]; !ok {
+			delete(buckets, k)
+		}
+	}
+	for k, q := range quotas {
+		if _, ok := keys[q.Limit]; !ok {
+			delete(quotas, k)
+		}
+	}
+}
+
+// "quotaFile" returns the path of the quota counters within the data directory.
+func quotaFile() string {
+	return config.GlobalConfig().DD + _const.FILESEPARATOR + _const.QUOTAFILENAME
+}
+
+// "LoadQuotas" reads the quota counters from the data directory, so a restart doesn't reset them.
+func LoadQuotas() error {
+	b, err := ioutil.ReadFile(quotaFile())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	q := make(map[string]*quota)
+	if err := json.Unmarshal(b, &q); err != nil {
+		return err
+	}
+	stateMux.Lock()
+	defer stateMux.Unlock()
+	quotas = q
+	return nil
+}
+
+// "SaveQuotas" writes the quota counters to the data directory.
+func SaveQuotas() error {
+	stateMux.Lock()
+	b, err := json.Marshal(quotas)
+	stateMux.Unlock()
+	if err != nil {
+		return err
+	}
+	tmp := quotaFile() + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, quotaFile())
+}
@@ -0,0 +1,97 @@
+// This package is for per-developer rate limits and quotas.
+package ratelimit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sync"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
+)
+
+// the developer id of the limit applied to developers without their own limits
+const anyDev = "*"
+
+// "Limit" is a single entry of rate_limits.json.
+type Limit struct {
+	DevID      string  `json:"devid"`
+	Blockchain string  `json:"blockchain"` // optional, limits only this chain
+	NetID      string  `json:"netid"`      // optional, limits only this chain
+	Rate       float64 `json:"rate"`       // sustained requests per second (0 is unlimited)
+	Burst      int     `json:"burst"`      // the bucket size of the rate
+	Daily      int64   `json:"daily"`      // requests per UTC day (0 is unlimited)
+	Monthly    int64   `json:"monthly"`    // requests per UTC month (0 is unlimited)
+}
+
+// "key" identifies a limit.
+func (l Limit) key() string {
+	return l.DevID + ":" + l.Blockchain + ":" + l.NetID
+}
+
+// "chainWide" returns true if the limit applies to every chain of the developer.
+func (l Limit) chainWide() bool {
+	return l.Blockchain == "" && l.NetID == ""
+}
+
+var (
+	limits    = make(map[string][]Limit) // <DevID><Limits>
+	limitsMux sync.Mutex
+)
+
+// "LFile" (re)builds the rate limits from the rate_limits.json file.
+func LFile() error {
+	lf := config.GlobalConfig().LFile
+	if lf == _const.LIMITSFILENAMEPLACEHOLDER {
+		lf = config.GlobalConfig().DD + _const.FILESEPARATOR + "rate_limits.json"
+	}
+	b, err := ioutil.ReadFile(lf)
+	if err != nil {
+		return err
+	}
+	var data []Limit
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	SetLimits(data)
+	return nil
+}
+
+// "SetLimits" replaces the rate limits, keeping the state of the buckets and quotas that are still limited.
+func SetLimits(data []Limit) {
+	m := make(map[string][]Limit)
+	for _, l := range data {
+		if l.DevID == "" {
+			continue
+		}
+		m[l.DevID] = append(m[l.DevID], l)
+	}
+	limitsMux.Lock()
+	limits = m
+	limitsMux.Unlock()
+	pruneState(data)
+}
+
+// "applicable" returns the limits that apply to a developer's request for the chains.
+func applicable(devID string, chains []Chain) []Limit {
+	limitsMux.Lock()
+	defer limitsMux.Unlock()
+	ls, ok := limits[devID]
+	if !ok {
+		ls = limits[anyDev]
+	}
+	result := make([]Limit, 0)
+	for _, l := range ls {
+		if l.chainWide() {
+			result = append(result, l)
+			continue
+		}
+		for _, c := range chains {
+			if l.Blockchain == c.Blockchain && (l.NetID == "" || l.NetID == c.NetID) {
+				result = append(result, l)
+				break
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,81 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/ratelimit"
+	"github.com/pokt-network/pocket-core/rpc/shared"
+	"github.com/pokt-network/pocket-core/service"
+)
+
+// "BatchResult" is the result of a single relay within a batch.
+type BatchResult struct {
+	Code       int    `json:"code"` // the status code of the hosted chain, or the error code of the relay
+	Result     string `json:"result,omitempty"`
+	Error      string `json:"error,omitempty"`
+	RetryAfter int    `json:"retryafter,omitempty"` // seconds until a rate limited relay may be retried
+}
+
+// "RelayBatch" handles the localhost:<relay-port>/v1/relay/batch call.
+// The relays are executed concurrently and the results are returned in the order of the relays.
+func RelayBatch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	relays := []service.Relay{}
+	if err := shared.PopModel(w, r, ps, &relays); err != nil {
+		logs.NewContextLog(r.Context(), err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		shared.WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if len(relays) == 0 || len(relays) > _const.MAXBATCHSIZE {
+		shared.WriteErrorResponse(w, 400, "A batch must hold between 1 and "+strconv.Itoa(_const.MAXBATCHSIZE)+" relays")
+		return
+	}
+	results := make([]BatchResult, len(relays))
+	var wg sync.WaitGroup
+	for i := range relays {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = batchRelay(r.Context(), relays[i])
+		}(i)
+	}
+	wg.Wait()
+	b, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		shared.WriteErrorResponse(w, 500, err.Error())
+		return
+	}
+	shared.WriteRawJSONResponse(w, b)
+}
+
+// "batchRelay" validates, rate limits and routes a single relay of a batch.
+func batchRelay(ctx context.Context, relay service.Relay) BatchResult {
+	if relay.Blockchain == "" || relay.NetworkID == "" || relay.DevID == "" || (relay.Data == "" && relay.Method == "") {
+		return BatchResult{Code: 400, Error: "The request was not properly formatted"}
+	}
+	response, err := service.RouteRelay(ctx, relay)
+	if err != nil {
+		result := BatchResult{Code: relayStatus(err), Error: err.Error()} // a canceled batch's response is never read
+		if e, ok := err.(*ratelimit.Error); ok {
+			result.Error, result.RetryAfter = "Rate limit exceeded", int(math.Ceil(e.RetryAfter.Seconds()))
+		}
+		if result.Code == http.StatusInternalServerError {
+			logs.NewContextLog(ctx, err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		}
+		return result
+	}
+	return BatchResult{Code: response.Code, Result: response.Body}
+}
+
+// "RelayBatchInfo" handles a get request to localhost:<relay-port>/v1/relay/batch call.
+func RelayBatchInfo(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	info := shared.InfoStruct(r, "RelayBatch", []service.Relay{{}}, "An ordered array of results with per relay errors")
+	shared.WriteInfoResponse(w, info)
+}
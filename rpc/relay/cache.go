@@ -0,0 +1,21 @@
+package relay
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/cache"
+	"github.com/pokt-network/pocket-core/rpc/shared"
+)
+
+// "Cache" handles the localhost:<relay-port>/v1/cache call.
+// It returns the hit, miss and eviction counters of the relay response cache.
+func Cache(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	b, err := json.MarshalIndent(cache.Relays().Stats(), "", "  ")
+	if err != nil {
+		shared.WriteErrorResponse(w, 500, err.Error())
+		return
+	}
+	shared.WriteRawJSONResponse(w, b)
+}
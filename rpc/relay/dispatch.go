@@ -7,6 +7,7 @@ import (
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/dispatch"
 	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/ratelimit"
 	"github.com/pokt-network/pocket-core/rpc/shared"
 )
 
@@ -14,16 +15,22 @@ import (
 func Dispatch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	d := &dispatch.Dispatch{}
 	if err := shared.PopModel(w, r, ps, d); err != nil {
-		logs.NewLog(err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		logs.NewContextLog(r.Context(), err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
 		shared.WriteErrorResponse(w, 400, err.Error())
 		return
 	}
 	if d.DevID == "" || len(d.Blockchains) == 0 {
 		shared.WriteErrorResponse(w, 400, "Request was not formatted properly")
+		return
+	}
+	res, err, code := dispatch.Serve(r.Context(), d)
+	if e, ok := err.(*ratelimit.Error); ok {
+		shared.WriteTooManyRequestsResponse(w, e.RetryAfter)
+		return
 	}
-	res, err, code := dispatch.Serve(d)
 	if err != nil {
 		shared.WriteErrorResponse(w, code, err.Error())
+		return
 	}
 	shared.WriteRawJSONResponse(w, res)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/pokt-network/pocket-core/config"
 	"github.com/pokt-network/pocket-core/const"
 	"github.com/pokt-network/pocket-core/db"
+	"github.com/pokt-network/pocket-core/dispatch"
 	"github.com/pokt-network/pocket-core/logs"
 	"github.com/pokt-network/pocket-core/node"
 	"github.com/pokt-network/pocket-core/rpc/shared"
@@ -26,58 +27,77 @@ func Register(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		shared.WriteErrorResponse(w, 410, "Deprecated, please upgrade software")
 		return
 	}
-	n := node.Node{}
+	reg := node.Registration{}
 	// if cannot populate model
-	if err := shared.PopModel(w, r, ps, &n); err != nil {
+	if err := shared.PopModel(w, r, ps, &reg); err != nil {
 		shared.WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	// if within white list
-	if node.EnsureSNWL(node.SWL(), n.GID) {
-		node.PeerList().Add(n)
-		node.DispatchPeers().Add(n)
-		if _, err := db.DB().Add(n); err != nil {
-			fmt.Println(err.Error())
-			shared.WriteErrorResponse(w, 500, "unable to write peer to database")
-			return
-		}
-		// if within migrate mode
-		if config.GlobalConfig().DisMode == _const.DISMODEMIGRATE {
-			_, err := service.HandleReport(&service.Report{IP: n.IP, Message: "This node has not upgraded Pocket Core"})
-			if err != nil {
-				logs.NewLog(err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
-			}
-			shared.WriteJSONResponse(w, "WARNING: Pocket Core is now in the Migration Phase. Please upgrade your software as this version will soon be deprecated and not supported")
-			return
+	// if not signed by a whitelisted node key
+	n, err := reg.Verify(node.RegisterAction)
+	if err != nil {
+		shared.WriteErrorResponse(w, 401, err.Error())
+		return
+	}
+	// the sync status changes between registrations, so it is tracked apart from the stored peer
+	dispatch.ObserveSync(n.GID, n.Sync)
+	n.Sync = nil
+	// persist before serving the node, so a restart never loses a registered peer
+	if err := db.Store().Add(n); err != nil {
+		fmt.Println(err.Error())
+		shared.WriteErrorResponse(w, 500, "unable to write peer to database")
+		return
+	}
+	node.PeerList().Add(n)
+	node.DispatchPeers().Add(n)
+	// if within migrate mode
+	if config.GlobalConfig().DisMode == _const.DISMODEMIGRATE {
+		_, err := service.HandleReport(&service.Report{IP: n.IP, Message: "This node has not upgraded Pocket Core"})
+		if err != nil {
+			logs.NewContextLog(r.Context(), err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
 		}
-		shared.WriteJSONResponse(w, "Success! Your node is now registered in the Pocket Network")
+		shared.WriteJSONResponse(w, "WARNING: Pocket Core is now in the Migration Phase. Please upgrade your software as this version will soon be deprecated and not supported")
 		return
 	}
-	shared.WriteErrorResponse(w, 401, "Invalid credentials")
+	shared.WriteJSONResponse(w, "Success! Your node is now registered in the Pocket Network")
 }
 
 // "UnRegister" handles the localhost:<relay-port>/v1/unregister call.
 func UnRegister(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	n := node.Node{}
-	if err := shared.PopModel(w, r, ps, &n); err != nil {
+	// if not a dispatcher
+	if !config.GlobalConfig().Dispatch {
+		shared.WriteErrorResponse(w, 405, "Not a dispatch node")
+		return
+	}
+	reg := node.Registration{}
+	if err := shared.PopModel(w, r, ps, &reg); err != nil {
 		shared.WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	node.PeerList().Remove(n)
-	node.DispatchPeers().Delete(n)
-	if _, err := db.DB().Remove(n); err != nil {
+	// only the owner of the node's key may unregister it
+	n, err := reg.Verify(node.UnRegisterAction)
+	if err != nil {
+		shared.WriteErrorResponse(w, 401, err.Error())
+		return
+	}
+	if err := db.Store().Remove(n); err != nil {
 		shared.WriteErrorResponse(w, 500, "unable to remove peer from database")
 		return
 	}
+	node.PeerList().Remove(n)
+	node.DispatchPeers().Delete(n)
+	dispatch.ForgetLatency(n.GID)
+	dispatch.ForgetSync(n.GID)
+	dispatch.ForgetServed(n.GID)
 	shared.WriteJSONResponse(w, "Success! Your node is now unregistered from the Pocket Network")
 }
 
 func RegisterInfo(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	info := shared.InfoStruct(r, "Register", node.Node{}, "Success or failure message")
+	info := shared.InfoStruct(r, "Register", node.Registration{}, "Success or failure message")
 	shared.WriteInfoResponse(w, info)
 }
 
 func UnRegisterInfo(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	info := shared.InfoStruct(r, "UnRegister", node.Node{}, "Success or failure message")
+	info := shared.InfoStruct(r, "UnRegister", node.Registration{}, "Success or failure message")
 	shared.WriteInfoResponse(w, info)
 }
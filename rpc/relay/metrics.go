@@ -0,0 +1,19 @@
+package relay
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/metrics"
+)
+
+// "Metrics" handles the localhost:<relay-port>/metrics call.
+// It serves the runtime metrics in the Prometheus text exposition format.
+func Metrics(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if err := metrics.Write(w); err != nil {
+		logs.NewContextLog(r.Context(), "unable to write the metrics: "+err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+	}
+}
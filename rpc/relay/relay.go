@@ -6,29 +6,69 @@ import (
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/ratelimit"
 	"github.com/pokt-network/pocket-core/rpc/shared"
 	"github.com/pokt-network/pocket-core/service"
+	"github.com/pokt-network/pocket-core/session"
 )
 
 // "Relay" handles the localhost:<relay-port>/v1/relaycall.
 func Relay(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	relay := &service.Relay{}
 	if err := shared.PopModel(w, r, ps, relay); err != nil {
-		logs.NewLog(err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		logs.NewContextLog(r.Context(), err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
 		shared.WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	if relay.Blockchain == "" || relay.NetworkID == "" || relay.DevID == "" || relay.Data == "" {
+	// rest relays (with a method) may have no data, e.g. GET requests
+	if relay.Blockchain == "" || relay.NetworkID == "" || relay.DevID == "" || (relay.Data == "" && relay.Method == "") {
 		shared.WriteErrorResponse(w, 400, "The request was not properly formatted")
 		return
 	}
-	response, err := service.RouteRelay(*relay)
+	// the upstream call is canceled if the developer disconnects
+	response, err := service.RouteRelay(r.Context(), *relay)
 	if err != nil {
-		logs.NewLog(err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
-		shared.WriteErrorResponse(w, 500, err.Error())
+		code := relayStatus(err)
+		if code == statusClientClosed {
+			return // nobody is listening
+		}
+		if e, ok := err.(*ratelimit.Error); ok {
+			shared.WriteTooManyRequestsResponse(w, e.RetryAfter)
+			return
+		}
+		if code == http.StatusInternalServerError {
+			logs.NewContextLog(r.Context(), err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		}
+		shared.WriteErrorResponse(w, code, err.Error())
 		return
 	}
-	shared.WriteJSONResponse(w, response) // relay the response
+	shared.WriteJSONResponseWithCode(w, response.Code, response.Body) // relay the response
+}
+
+// the status of relays canceled by the developer (nginx's "client closed request")
+const statusClientClosed = 499
+
+// "relayStatus" returns the http status of a relay that failed with the error.
+func relayStatus(err error) int {
+	if _, ok := err.(*service.MethodError); ok {
+		return http.StatusForbidden
+	}
+	if _, ok := err.(*ratelimit.Error); ok {
+		return http.StatusTooManyRequests
+	}
+	switch err {
+	case service.ErrUnhostedChain:
+		return http.StatusNotFound
+	case session.ErrNotInSession:
+		return http.StatusForbidden
+	case service.ErrUnhealthyChain, service.ErrLaggingChain:
+		return http.StatusServiceUnavailable
+	case service.ErrRelayTimeout:
+		return http.StatusGatewayTimeout
+	case service.ErrRelayCanceled:
+		return statusClientClosed
+	}
+	return http.StatusInternalServerError
 }
 
 // "RelayInfo" handles a get request to localhost:<relay-port>/v1/relay call.
@@ -42,19 +82,19 @@ func RelayInfo(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 func Report(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	report := &service.Report{}
 	if err := shared.PopModel(w, r, ps, report); err != nil {
-		logs.NewLog(err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		logs.NewContextLog(r.Context(), err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
 		shared.WriteErrorResponse(w, 400, err.Error())
 		return
 	}
 	if report.IP == "" || report.Message == "" {
 		err := errors.New("empty field error")
-		logs.NewLog(err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		logs.NewContextLog(r.Context(), err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
 		shared.WriteErrorResponse(w, 401, err.Error())
 		return
 	}
 	response, err := service.HandleReport(report)
 	if err != nil {
-		logs.NewLog(err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		logs.NewContextLog(r.Context(), err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
 	}
 	shared.WriteJSONResponse(w, response)
 }
@@ -18,11 +18,21 @@ func Routes() shared.Routes {
 		shared.Route{Name: "DispatchInfo", Method: "GET", Path: "/v1/dispatch", HandlerFunc: DispatchInfo},
 		shared.Route{Name: "Relay", Method: "POST", Path: "/v1/relay/", HandlerFunc: Relay},
 		shared.Route{Name: "RelayInfo", Method: "GET", Path: "/v1/relay", HandlerFunc: RelayInfo},
+		shared.Route{Name: "RelayBatch", Method: "POST", Path: "/v1/relay/batch", HandlerFunc: RelayBatch},
+		shared.Route{Name: "RelayBatchInfo", Method: "GET", Path: "/v1/relay/batch", HandlerFunc: RelayBatchInfo},
+		shared.Route{Name: "RelayWS", Method: "GET", Path: "/v1/relay/ws", HandlerFunc: RelayWS},
 		shared.Route{Name: "Register", Method: "POST", Path: "/v1/register", HandlerFunc: Register},
 		shared.Route{Name: "UnRegister", Method: "POST", Path: "/v1/unregister", HandlerFunc: UnRegister},
 		shared.Route{Name: "RegisterInfo", Method: "GET", Path: "/v1/register", HandlerFunc: RegisterInfo},
 		shared.Route{Name: "UnRegisterInfo", Method: "GET", Path: "/v1/unregister", HandlerFunc: UnRegisterInfo},
 		shared.Route{Name: "WhiteList", Method: "POST", Path: "/v1/whitelist", HandlerFunc: WhiteList},
+		shared.Route{Name: "Session", Method: "POST", Path: "/v1/session", HandlerFunc: Session},
+		shared.Route{Name: "SessionInfo", Method: "GET", Path: "/v1/session", HandlerFunc: SessionInfo},
+		shared.Route{Name: "Usage", Method: "POST", Path: "/v1/usage", HandlerFunc: Usage},
+		shared.Route{Name: "UsageInfo", Method: "GET", Path: "/v1/usage", HandlerFunc: UsageInfo},
+		shared.Route{Name: "Cache", Method: "GET", Path: "/v1/cache", HandlerFunc: Cache},
+		shared.Route{Name: "Self", Method: "GET", Path: "/v1/self", HandlerFunc: Self},
+		shared.Route{Name: "Metrics", Method: "GET", Path: "/metrics", HandlerFunc: Metrics},
 		shared.Route{Name: "Flags", Method: "GET", Path: "/v1/flags", HandlerFunc: Flags},
 	}
 	return routes
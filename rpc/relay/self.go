@@ -0,0 +1,26 @@
+package relay
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/rpc/shared"
+)
+
+// "Self" handles the localhost:<relay-port>/v1/self call.
+// It returns the node as advertised (only its healthy chains) along with the sync status of its hosted chains.
+func Self(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	self, err := node.Self()
+	if err != nil {
+		shared.WriteErrorResponse(w, 500, err.Error())
+		return
+	}
+	b, err := json.MarshalIndent(self, "", "  ")
+	if err != nil {
+		shared.WriteErrorResponse(w, 500, err.Error())
+		return
+	}
+	shared.WriteRawJSONResponse(w, b)
+}
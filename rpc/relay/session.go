@@ -0,0 +1,54 @@
+package relay
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/rpc/shared"
+	"github.com/pokt-network/pocket-core/session"
+)
+
+// "Session" handles the localhost:<relay-port>/v1/session call.
+func Session(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	// if not a dispatcher
+	if !config.GlobalConfig().Dispatch {
+		shared.WriteErrorResponse(w, 405, "Not a dispatch node")
+		return
+	}
+	req := &session.Request{}
+	if err := shared.PopModel(w, r, ps, req); err != nil {
+		logs.NewContextLog(r.Context(), err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		shared.WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if req.DevID == "" || req.Blockchain.Name == "" {
+		shared.WriteErrorResponse(w, 400, "Request was not formatted properly")
+		return
+	}
+	if !node.EnsureDWL(node.DWL(), req.DevID) {
+		shared.WriteErrorResponse(w, 401, "Invalid credentials")
+		return
+	}
+	epoch := req.Epoch
+	if epoch == 0 {
+		epoch = session.CurrentEpoch()
+	}
+	s := session.NewSession(req.DevID, req.Blockchain, epoch, node.DispatchPeers().NodesByChain(req.Blockchain))
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		shared.WriteErrorResponse(w, 500, err.Error())
+		return
+	}
+	shared.WriteRawJSONResponse(w, b)
+}
+
+// "SessionInfo" handles a get request to localhost:<relay-port>/v1/session call.
+// And provides the service nodes with an in-client reference to the API call
+func SessionInfo(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	info := shared.InfoStruct(r, "Session", session.Request{}, "The developer's session and its nodes")
+	shared.WriteInfoResponse(w, info)
+}
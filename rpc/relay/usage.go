@@ -0,0 +1,44 @@
+package relay
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/rpc/shared"
+	"github.com/pokt-network/pocket-core/usage"
+)
+
+// "Usage" handles the localhost:<relay-port>/v1/usage call.
+func Usage(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	q := &usage.Query{}
+	if err := shared.PopModel(w, r, ps, q); err != nil {
+		logs.NewContextLog(r.Context(), err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		shared.WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if q.DevID == "" || (q.To != 0 && q.From > q.To) {
+		shared.WriteErrorResponse(w, 400, "Request was not formatted properly")
+		return
+	}
+	// developers may only query their own usage
+	if !node.EnsureDWL(node.DWL(), q.DevID) {
+		shared.WriteErrorResponse(w, 401, "Invalid credentials")
+		return
+	}
+	b, err := json.MarshalIndent(usage.Usage(*q), "", "  ")
+	if err != nil {
+		shared.WriteErrorResponse(w, 500, err.Error())
+		return
+	}
+	shared.WriteRawJSONResponse(w, b)
+}
+
+// "UsageInfo" handles a get request to localhost:<relay-port>/v1/usage call.
+// And provides the developers with an in-client reference to the API call
+func UsageInfo(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	info := shared.InfoStruct(r, "Usage", usage.Query{}, "The relay usage within the time window (unix seconds), by hour")
+	shared.WriteInfoResponse(w, info)
+}
@@ -10,14 +10,15 @@ import (
 
 // "WhiteList" handles the localhost:<relay-port>/v1/whitelist call.
 func WhiteList(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	nd := &node.Node{}
-	err := shared.PopModel(w, r, ps, nd)
+	reg := &node.Registration{}
+	err := shared.PopModel(w, r, ps, reg)
 	if err != nil {
 		shared.WriteErrorResponse(w, 500, err.Error())
 		return
 	}
-	if !node.EnsureSNWL(node.SNWL, nd.GID) {
-		shared.WriteErrorResponse(w, 401, "invalid authentication")
+	// only whitelisted service nodes may read the developer whitelist
+	if _, err := reg.Verify(node.WhiteListAction); err != nil {
+		shared.WriteErrorResponse(w, 401, err.Error())
 		return
 	}
 	b, err := json.MarshalIndent(node.DevWL.ToSlice(), "", "")
@@ -0,0 +1,61 @@
+package relay
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/ratelimit"
+	"github.com/pokt-network/pocket-core/service"
+)
+
+// developers connect from anywhere, relays are authorized by the developer whitelist
+var upgrader = websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }}
+
+// "RelayWS" handles the localhost:<relay-port>/v1/relay/ws call.
+// The first message is the relay, the responses (and subscription notifications) of the hosted chain are streamed back
+// and later messages are forwarded to the hosted chain on the same connection.
+func RelayWS(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	// the handshake's response carries the request id
+	conn, err := upgrader.Upgrade(w, r, w.Header())
+	if err != nil {
+		return // the upgrader responds with the error
+	}
+	defer conn.Close()
+	relay := &service.Relay{}
+	if err := conn.ReadJSON(relay); err != nil {
+		closeWS(conn, websocket.CloseUnsupportedData, err.Error())
+		return
+	}
+	if relay.Blockchain == "" || relay.NetworkID == "" || relay.DevID == "" || relay.Data == "" {
+		closeWS(conn, websocket.CloseUnsupportedData, "The request was not properly formatted")
+		return
+	}
+	if err := service.StreamRelay(r.Context(), *relay, conn); err != nil {
+		if _, ok := err.(*service.MethodError); ok {
+			closeWS(conn, websocket.ClosePolicyViolation, err.Error())
+			return
+		}
+		if err == service.ErrUnhostedChain {
+			closeWS(conn, websocket.CloseUnsupportedData, err.Error())
+			return
+		}
+		if _, ok := err.(*ratelimit.Error); ok {
+			closeWS(conn, websocket.ClosePolicyViolation, err.Error())
+			return
+		}
+		logs.NewContextLog(r.Context(), err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		closeWS(conn, websocket.CloseInternalServerErr, err.Error())
+	}
+}
+
+// "closeWS" sends a close message with the reason to the developer.
+func closeWS(conn *websocket.Conn, code int, reason string) {
+	// the reason of a close message is limited to 123 bytes
+	if len(reason) > 123 {
+		reason = reason[:123]
+	}
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+}
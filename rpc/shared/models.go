@@ -12,8 +12,9 @@ type JSONErrorResponse struct {
 
 // "APIError" is an error feedback structure containing a title and a status.
 type APIError struct {
-	Status int    `json:"code"`
-	Title  string `json:"title"`
+	Status     int    `json:"code"`
+	Title      string `json:"title"`
+	RetryAfter int    `json:"retryafter,omitempty"` // seconds until the request may be retried
 }
 
 // "APIReference' is an in-client API reference.
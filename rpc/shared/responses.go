@@ -4,13 +4,21 @@ package shared
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // "WriteJSONResponse" writes a JSON response.
 func WriteJSONResponse(w http.ResponseWriter, m string) {
+	WriteJSONResponseWithCode(w, http.StatusOK, m)
+}
+
+// "WriteJSONResponseWithCode" writes a JSON response with the status code.
+func WriteJSONResponseWithCode(w http.ResponseWriter, code int, m string) {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
-	w.WriteHeader(http.StatusOK)
+	w.WriteHeader(code)
 	b, err := json.MarshalIndent(m, "", "\t")
 	if err != nil {
 		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -50,3 +58,12 @@ func WriteErrorResponse(w http.ResponseWriter, errorCode int, errorMsg string) {
 	w.WriteHeader(errorCode)
 	json.NewEncoder(w).Encode(&JSONErrorResponse{Error: &APIError{Status: errorCode, Title: errorMsg}})
 }
+
+// "WriteTooManyRequestsResponse" writes a 429 error JSON response with the retry after information.
+func WriteTooManyRequestsResponse(w http.ResponseWriter, retryAfter time.Duration) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	w.WriteHeader(http.StatusTooManyRequests)
+	json.NewEncoder(w).Encode(&JSONErrorResponse{Error: &APIError{Status: http.StatusTooManyRequests, Title: "Rate limit exceeded", RetryAfter: seconds}})
+}
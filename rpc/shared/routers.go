@@ -1,13 +1,49 @@
 // This package is shared between the different RPC packages
 package shared
 
-import "github.com/julienschmidt/httprouter"
+import (
+	"encoding/hex"
+	"net/http"
+	"regexp"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/pokt-network/pocket-core/logs"
+)
+
+// "validRequestID" matches the request ids accepted from the caller.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
 
 // "Router" creates a new httprouter from all of the routes and corresponding functions dealing with local calls.
+// Every request is tagged with a request id (see RequestID).
 func Router(routes Routes) *httprouter.Router {
 	router := httprouter.New()
 	for _, route := range routes {
-		router.Handle(route.Method, route.Path, route.HandlerFunc)
+		router.Handle(route.Method, route.Path, RequestID(route.HandlerFunc))
 	}
 	return router
 }
+
+// "RequestID" wraps the handler so the request's context carries a request id, which is echoed in the response header.
+// The id of the caller's header is kept (so a relay can be followed from the dispatcher to the hosted chain),
+// otherwise a new one is generated.
+func RequestID(h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		id := r.Header.Get(_const.REQUESTIDHEADER)
+		if !validRequestID.MatchString(id) {
+			id = newRequestID()
+		}
+		w.Header().Set(_const.REQUESTIDHEADER, id)
+		h(w, r.WithContext(logs.WithRequestID(r.Context(), id)), ps)
+	}
+}
+
+// "newRequestID" generates a random request id.
+func newRequestID() string {
+	b, err := crypto.RandBytes(16)
+	if err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
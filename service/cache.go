@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pokt-network/pocket-core/cache"
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/plugin"
+)
+
+// the block tags whose block changes over time, relays referring to them are never cached
+var movingTags = []string{`"latest"`, `"pending"`, `"safe"`, `"finalized"`}
+
+// "blockNumber" matches the hex quantities of json rpc params that may be block numbers (hashes are longer)
+var blockNumber = regexp.MustCompile(`^0x[0-9a-fA-F]{1,16}$`)
+
+// "cachedRelay" serves the relay from the response cache if the chain caches its method, executing it otherwise.
+func cachedRelay(ctx context.Context, relay Relay, bc node.Blockchain) (plugin.Response, error) {
+	key, id, ttl := cacheKey(relay, node.ChainToHosted(bc))
+	if key == "" {
+		return executeRelay(ctx, relay, bc)
+	}
+	if b, ok := cache.Relays().Get(key); ok {
+		if body, err := withID(b, id); err == nil {
+			return plugin.Response{Code: http.StatusOK, Body: body}, nil
+		}
+	}
+	resp, err := executeRelay(ctx, relay, bc)
+	if err == nil && resp.Code == http.StatusOK {
+		if b, ok := cacheable(resp.Body); ok {
+			cache.Relays().Set(key, b, ttl)
+		}
+	}
+	return resp, err
+}
+
+// "cacheKey" returns the cache key of the json rpc relay (chain, netid and its normalized method and params),
+// the id of the request and the ttl of its method. The key is empty if the relay isn't cached.
+func cacheKey(relay Relay, hc node.HostedChain) (string, json.RawMessage, time.Duration) {
+	if config.GlobalConfig().CacheSize <= 0 || len(hc.Cache) == 0 || hc.Medium == _const.MEDIUMREST {
+		return "", nil, 0
+	}
+	req := struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params interface{}     `json:"params"`
+	}{}
+	// batches and invalid requests aren't cached
+	if err := json.Unmarshal([]byte(relay.Data), &req); err != nil || req.Method == "" {
+		return "", nil, 0
+	}
+	ttl := hc.CacheTTL(req.Method)
+	if ttl <= 0 {
+		return "", nil, 0
+	}
+	// re-encoding drops whitespace and sorts object keys
+	params, err := json.Marshal(req.Params)
+	if err != nil {
+		return "", nil, 0
+	}
+	for _, tag := range movingTags {
+		if strings.Contains(string(params), tag) {
+			return "", nil, 0
+		}
+	}
+	if !final(req.Params, hc) {
+		return "", nil, 0
+	}
+	return hc.Name + "/" + hc.NetID + "/" + req.Method + "/" + string(params), req.ID, ttl
+}
+
+// "final" returns whether every block the params may refer to is at least the chain's finality below its head
+// (see HostedChain.Finality), so a reorg can't change the response. Every number within the params is taken as
+// a block, as there is no telling them apart. Params without numbers are final, the ones with are not if the
+// height of the chain is unknown.
+func final(params interface{}, hc node.HostedChain) bool {
+	switch p := params.(type) {
+	case []interface{}:
+		for _, v := range p {
+			if !final(v, hc) {
+				return false
+			}
+		}
+	case map[string]interface{}:
+		for _, v := range p {
+			if !final(v, hc) {
+				return false
+			}
+		}
+	case float64:
+		return p >= 0 && finalBlock(uint64(p), hc)
+	case string:
+		if blockNumber.MatchString(p) {
+			n, err := strconv.ParseUint(p[2:], 16, 64)
+			return err == nil && finalBlock(n, hc)
+		}
+	}
+	return true
+}
+
+// "finalBlock" returns whether the block is at least the chain's finality below its last known height.
+func finalBlock(n uint64, hc node.HostedChain) bool {
+	height := node.ChainHeight(hc.Blockchain)
+	return height >= hc.Finality() && n <= height-hc.Finality()
+}
+
+// "cacheable" returns the json rpc response without its id, if it is a successful one.
+// A null result (e.g. a block that doesn't exist yet) is not cached, as it will change.
+func cacheable(body string) ([]byte, bool) {
+	resp := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		return nil, false
+	}
+	if r, ok := resp["result"]; !ok || string(r) == "null" {
+		return nil, false
+	}
+	if e, ok := resp["error"]; ok && string(e) != "null" {
+		return nil, false
+	}
+	delete(resp, "id")
+	b, err := json.Marshal(resp)
+	return b, err == nil
+}
+
+// "withID" returns the cached json rpc response answering the request with the id.
+func withID(b []byte, id json.RawMessage) (string, error) {
+	resp := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return "", err
+	}
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	resp["id"] = id
+	out, err := json.Marshal(resp)
+	return string(out), err
+}
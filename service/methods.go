@@ -0,0 +1,99 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/node"
+)
+
+// "MethodError" is returned for relays calling a json rpc method the hosted chain doesn't allow.
+type MethodError struct {
+	Method string // empty if the relay isn't a json rpc request
+}
+
+func (e *MethodError) Error() string {
+	if e.Method == "" {
+		return "the relay is not a json rpc request, the hosted chain only relays allowed methods"
+	}
+	return "the method " + e.Method + " is not allowed on the hosted chain"
+}
+
+// "checkMethods" rejects relays calling methods outside of the hosted chain's allow and deny lists.
+// Every request of a json rpc batch is checked, relays to rest chains are not.
+// The medium is decided by the hosted chain: the other mediums ignore the http method of a relay and send its data,
+// so a relay with one is rejected rather than let through unchecked.
+func checkMethods(ctx context.Context, relay Relay, hc node.HostedChain, data []byte) error {
+	if hc.Medium == _const.MEDIUMREST {
+		return nil
+	}
+	if relay.Method != "" {
+		logs.NewContextLog(ctx, relay.DevID+" relayed a rest request to "+hc.Name+" NetID:"+hc.NetID, logs.WaringLevel, logs.JSONLogFormat)
+		return &MethodError{}
+	}
+	if len(hc.Allow) == 0 && len(hc.Deny) == 0 {
+		return nil
+	}
+	reqs := make([]json.RawMessage, 1)
+	var err error
+	if d := strings.TrimSpace(string(data)); strings.HasPrefix(d, "[") {
+		reqs = reqs[:0]
+		err = json.Unmarshal(data, &reqs)
+	} else {
+		reqs[0] = data
+	}
+	if err != nil || len(reqs) == 0 {
+		logs.NewContextLog(ctx, relay.DevID+" relayed a malformed request to "+hc.Name+" NetID:"+hc.NetID, logs.WaringLevel, logs.JSONLogFormat)
+		return &MethodError{}
+	}
+	for _, r := range reqs {
+		method, ok := requestMethod(r)
+		if !ok {
+			logs.NewContextLog(ctx, relay.DevID+" relayed a malformed request to "+hc.Name+" NetID:"+hc.NetID, logs.WaringLevel, logs.JSONLogFormat)
+			return &MethodError{}
+		}
+		if !hc.MethodAllowed(method) {
+			logs.NewContextLog(ctx, relay.DevID+" relayed the denied method "+method+" to "+hc.Name+" NetID:"+hc.NetID, logs.WaringLevel, logs.JSONLogFormat)
+			return &MethodError{Method: method}
+		}
+	}
+	return nil
+}
+
+// "requestMethod" returns the method of a json rpc request, which must have exactly one "method" key.
+// Hosted chains differ on case sensitivity and duplicate keys (encoding/json matches any case and keeps the last),
+// so requests with other spellings of the key, or several of them, are rejected rather than guessed.
+func requestMethod(data []byte) (string, bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return "", false
+	}
+	var method string
+	found := false
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return "", false
+		}
+		key, _ := t.(string)
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return "", false
+		}
+		if !strings.EqualFold(key, "method") {
+			continue
+		}
+		if key != "method" || found || json.Unmarshal(value, &method) != nil {
+			return "", false
+		}
+		found = true
+	}
+	if t, err := dec.Token(); err != nil || t != json.Delim('}') {
+		return "", false
+	}
+	return method, found && method != ""
+}
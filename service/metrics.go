@@ -0,0 +1,29 @@
+package service
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/pokt-network/pocket-core/metrics"
+	"github.com/pokt-network/pocket-core/plugin"
+)
+
+var (
+	relayCount = metrics.NewCounter("pocket_relays_total",
+		"The relays executed by the hosted chains, by result (success, error or timeout).", "blockchain", "netid", "result")
+	relayDuration = metrics.NewHistogram("pocket_relay_duration_seconds",
+		"The time to execute a relay, cached responses and failover included.", metrics.DurationBuckets, "blockchain", "netid")
+)
+
+// "observeRelay" records the result and duration of an executed relay.
+func observeRelay(relay Relay, resp plugin.Response, err error, d time.Duration) {
+	result := "success"
+	switch {
+	case err == ErrRelayTimeout:
+		result = "timeout"
+	case err != nil || resp.Code >= http.StatusInternalServerError:
+		result = "error"
+	}
+	relayCount.Inc(relay.Blockchain, relay.NetworkID, result)
+	relayDuration.Observe(d.Seconds(), relay.Blockchain, relay.NetworkID)
+}
@@ -2,38 +2,218 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
 	"net/url"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/pokt-network/pocket-core/config"
 	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/logs"
 	"github.com/pokt-network/pocket-core/node"
-	"github.com/pokt-network/pocket-core/plugin/rpc"
+	"github.com/pokt-network/pocket-core/plugin"
+	// the mediums register themselves with the plugin registry
+	_ "github.com/pokt-network/pocket-core/plugin/rest"
+	_ "github.com/pokt-network/pocket-core/plugin/rpc"
+	_ "github.com/pokt-network/pocket-core/plugin/tcp"
+	"github.com/pokt-network/pocket-core/plugin/ws"
+	"github.com/pokt-network/pocket-core/ratelimit"
+	"github.com/pokt-network/pocket-core/session"
+	"github.com/pokt-network/pocket-core/usage"
 )
 
 // "Relay" is a JSON structure that specifies information to complete reads and writes to other blockchains
-type Relay struct {
-	Blockchain string `json:"blockchain"`
-	NetworkID  string `json:"netid"`
-	Data       string `json:"data"`
-	DevID      string `json:"devid"`
-}
+type Relay = plugin.Relay
+
+// "ErrUnhostedChain" is returned for relays to a blockchain this node doesn't host.
+var ErrUnhostedChain = errors.New("the blockchain is not hosted by this node")
+
+// "ErrUnhealthyChain" is returned for relays to a hosted chain that failed its last health check.
+var ErrUnhealthyChain = errors.New("the hosted chain is currently unhealthy")
+
+// "ErrLaggingChain" is returned for relays to a hosted chain that is behind its reference client.
+var ErrLaggingChain = errors.New("the hosted chain is currently syncing")
 
-// "RouteRelay" routes the relay to the specified hosted chain
-func RouteRelay(relay Relay) (string, error) {
+// "ErrRelayTimeout" is returned for relays the hosted chain didn't answer within its timeout.
+var ErrRelayTimeout = errors.New("the hosted chain did not respond in time")
+
+// "ErrRelayCanceled" is returned for relays canceled by the developer (e.g. a closed connection).
+var ErrRelayCanceled = errors.New("the relay was canceled")
+
+// "RouteRelay" routes the relay to the specified hosted chain.
+// The relay is abandoned once the context is done, or after the chain's timeout.
+func RouteRelay(ctx context.Context, relay Relay) (plugin.Response, error) {
 	if node.EnsureDWL(node.DWL(), relay.DevID) {
-		hc := node.ChainToHosted(node.Blockchain{Name: relay.Blockchain, NetID: relay.NetworkID})
-		u, err := url.ParseRequestURI(hc.Host + ":" + hc.Port)
-		if err != nil {
-			return "", err
+		bc := node.Blockchain{Name: relay.Blockchain, NetID: relay.NetworkID}
+		// rejected before anything is recorded, so made up chains never become metric series or usage keys
+		if !node.ChainHosted(bc) {
+			return plugin.Response{}, ErrUnhostedChain
+		}
+		if err := checkSession(ctx, relay.DevID, bc); err != nil {
+			return plugin.Response{}, err
+		}
+		if !node.ChainHealthy(bc) {
+			return plugin.Response{}, ErrUnhealthyChain
+		}
+		if !node.ChainSynced(bc) {
+			return plugin.Response{}, ErrLaggingChain
+		}
+		if err := checkMethods(ctx, relay, node.ChainToHosted(bc), []byte(relay.Data)); err != nil {
+			return plugin.Response{}, err
+		}
+		// only relays that are routed count against the developer's limits
+		if err := ratelimit.Check(relay.DevID, ratelimit.Chain{Blockchain: relay.Blockchain, NetID: relay.NetworkID}); err != nil {
+			return plugin.Response{}, err
+		}
+		start := time.Now()
+		response, err := cachedRelay(ctx, relay, bc)
+		latency := time.Since(start)
+		usage.Meter(usage.Record{
+			Key:           usage.Key{DevID: relay.DevID, Blockchain: relay.Blockchain, NetID: relay.NetworkID, GID: config.GlobalConfig().GID},
+			RequestBytes:  len(relay.Data),
+			ResponseBytes: len(response.Body),
+			Latency:       latency,
+			Err:           err})
+		observeRelay(relay, response, err, latency)
+		return response, err
+	}
+	return plugin.Response{Code: http.StatusOK, Body: "Invalid credentials"}, nil
+}
+
+// "checkSession" verifies that this node is within the developer's session (if session checks are enabled).
+func checkSession(ctx context.Context, devID string, bc node.Blockchain) error {
+	if !config.GlobalConfig().SessCheck {
+		return nil
+	}
+	self, err := node.Self()
+	if err != nil {
+		return err
+	}
+	return session.Verify(ctx, devID, bc, self.GID)
+}
+
+// "executeRelay" forwards the relay to the hosted chain through the plugin of its medium.
+// On a connection error or 5xx response the relay is retried on the next upstream of the chain,
+// as long as the chain's timeout (which covers every attempt) hasn't passed.
+func executeRelay(ctx context.Context, relay Relay, bc node.Blockchain) (plugin.Response, error) {
+	hc := node.ChainToHosted(bc)
+	if _, err := plugin.Get(hc.Medium); err != nil {
+		return plugin.Response{}, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, relayTimeout(hc))
+	defer cancel()
+	var resp plugin.Response
+	var err error
+	for _, u := range hc.Endpoints() {
+		resp, err = plugin.Execute(ctx, relay, hc.At(u))
+		// the developer going away says nothing about the upstream
+		if ctx.Err() == context.Canceled {
+			return plugin.Response{}, ErrRelayCanceled
+		}
+		ok := err == nil && resp.Code < 500
+		node.ReportUpstream(u, ok)
+		if ok {
+			break
+		}
+		logUpstream(ctx, u, resp, err)
+		if ctx.Err() == context.DeadlineExceeded {
+			return plugin.Response{}, ErrRelayTimeout
+		}
+	}
+	return resp, err
+}
+
+// "logUpstream" logs a failed attempt on an upstream of the hosted chain, tagged with the relay's request id.
+func logUpstream(ctx context.Context, u node.Upstream, resp plugin.Response, err error) {
+	msg := "status " + strconv.Itoa(resp.Code)
+	if err != nil {
+		msg = err.Error()
+	}
+	logs.NewContextLog(ctx, "the relay to the upstream "+u.Host+":"+u.Port+" failed: "+msg, logs.WaringLevel, logs.JSONLogFormat)
+}
+
+// "relayTimeout" returns the time to wait for the hosted chain's response to a relay.
+func relayTimeout(hc node.HostedChain) time.Duration {
+	if hc.Timeout > 0 {
+		return time.Duration(hc.Timeout) * time.Millisecond
+	}
+	return time.Duration(config.GlobalConfig().RelayTimeout) * time.Millisecond
+}
+
+// "StreamRelay" sends the relay to the hosted chain over a dedicated websocket connection and streams
+// the responses (e.g. the notifications of eth_subscribe) back to the developer's connection.
+// Later developer messages are forwarded on the same connection, each counting against the developer's limits.
+// The context carries the request id of the developer's connection.
+func StreamRelay(ctx context.Context, relay Relay, dev *websocket.Conn) error {
+	if !node.EnsureDWL(node.DWL(), relay.DevID) {
+		return errors.New("Invalid credentials")
+	}
+	bc := node.Blockchain{Name: relay.Blockchain, NetID: relay.NetworkID}
+	if !node.ChainHosted(bc) {
+		return ErrUnhostedChain
+	}
+	if err := checkSession(ctx, relay.DevID, bc); err != nil {
+		return err
+	}
+	if !node.ChainHealthy(bc) {
+		return ErrUnhealthyChain
+	}
+	if !node.ChainSynced(bc) {
+		return ErrLaggingChain
+	}
+	hc := node.ChainToHosted(bc)
+	if hc.Medium != _const.MEDIUMWS {
+		return errors.New("the blockchain is not hosted over websockets")
+	}
+	if err := checkMethods(ctx, relay, hc, []byte(relay.Data)); err != nil {
+		return err
+	}
+	rateChain := ratelimit.Chain{Blockchain: relay.Blockchain, NetID: relay.NetworkID}
+	if err := ratelimit.Check(relay.DevID, rateChain); err != nil {
+		return err
+	}
+	var err error
+	var chain *websocket.Conn
+	for _, up := range hc.Endpoints() {
+		var u *url.URL
+		if u, err = ws.URL(up.Host, up.Port, up.Path); err != nil {
+			return err
+		}
+		chain, err = ws.Dial(u)
+		node.ReportUpstream(up, err == nil)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer chain.Close()
+	key := usage.Key{DevID: relay.DevID, Blockchain: relay.Blockchain, NetID: relay.NetworkID, GID: config.GlobalConfig().GID}
+	err = chain.WriteMessage(websocket.TextMessage, []byte(relay.Data))
+	usage.Meter(usage.Record{Key: key, RequestBytes: len(relay.Data), Err: err})
+	if err != nil {
+		return err
+	}
+	onRequest := func(msg []byte) error {
+		if err := checkMethods(ctx, relay, hc, msg); err != nil {
+			return err
 		}
-		if hc.Path != "" {
-			u.Path = hc.Path
+		if err := ratelimit.Check(relay.DevID, rateChain); err != nil {
+			return err
 		}
-		return rpc.ExecuteRequest([]byte(relay.Data), u)
+		usage.Meter(usage.Record{Key: key, RequestBytes: len(msg)})
+		return nil
+	}
+	onResponse := func(msg []byte) {
+		usage.MeterResponse(key, len(msg))
 	}
-	return "Invalid credentials", nil
+	return ws.Stream(dev, chain, onRequest, onResponse)
 }
 
 type Report struct {
@@ -0,0 +1,152 @@
+// This package is for deterministic developer sessions.
+package session
+
+import (
+	_ "crypto/sha1" // registers the session hashing algorithm
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/node"
+)
+
+// "Session" is the set of nodes serving a developer on a blockchain for an epoch.
+type Session struct {
+	DevID      string          `json:"devid"`
+	Blockchain node.Blockchain `json:"blockchain"`
+	Epoch      int64           `json:"epoch"`
+	Key        string          `json:"key"`
+	GIDs       []string        `json:"gids"`
+	Ips        []string        `json:"ips"`
+}
+
+// "Request" is the relay api request for a session.
+type Request struct {
+	DevID      string          `json:"devid"`
+	Blockchain node.Blockchain `json:"blockchain"`
+	Epoch      int64           `json:"epoch"` // 0 is the current epoch
+}
+
+// "CurrentEpoch" returns the epoch of the present time.
+func CurrentEpoch() int64 {
+	return EpochOf(time.Now())
+}
+
+// "EpochOf" returns the epoch of a time.
+func EpochOf(t time.Time) int64 {
+	length := int64(config.GlobalConfig().SessLength)
+	if length <= 0 {
+		length = _const.SESSIONLENGTH
+	}
+	return t.Unix() / length
+}
+
+// "Key" hashes the session identifiers with the session hashing algorithm.
+func Key(devID string, bc node.Blockchain, epoch int64) string {
+	return hash(devID + ":" + bc.Name + ":" + bc.NetID + ":" + strconv.FormatInt(epoch, 10))
+}
+
+func hash(s string) string {
+	h := _const.SESSALGO.New()
+	h.Write([]byte(s))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// "selection" is the node set selected for a session.
+type selection struct {
+	epoch int64
+	gids  []string
+}
+
+var (
+	selections    = make(map[string]selection) // <Key><selection>
+	selectionLock sync.Mutex
+)
+
+// "NewSession" derives the session of a developer from the registered nodes of the blockchain.
+func NewSession(devID string, bc node.Blockchain, epoch int64, nodes []node.Node) *Session {
+	s := &Session{DevID: devID, Blockchain: bc, Epoch: epoch, Key: Key(devID, bc, epoch), GIDs: make([]string, 0), Ips: make([]string, 0)}
+	for _, n := range s.Nodes(nodes) {
+		s.GIDs = append(s.GIDs, n.GID)
+		s.Ips = append(s.Ips, n.IP+":"+n.RelayPort)
+	}
+	return s
+}
+
+// "Nodes" returns the nodes of the session, selected once and cached until the epoch ends.
+// Nodes that joined since are not added and cached nodes missing from nodes (dead or unregistered) are dropped,
+// so the live nodes of a session never change within its epoch. A session left without nodes is selected again.
+func (s *Session) Nodes(nodes []node.Node) []node.Node {
+	selectionLock.Lock()
+	defer selectionLock.Unlock()
+	byGID := make(map[string]node.Node, len(nodes))
+	for _, n := range nodes {
+		byGID[n.GID] = n
+	}
+	result := make([]node.Node, 0)
+	sel, ok := selections[s.Key]
+	if ok {
+		gids := make([]string, 0, len(sel.gids))
+		for _, gid := range sel.gids {
+			if n, ok := byGID[gid]; ok {
+				gids = append(gids, gid)
+				result = append(result, n)
+			}
+		}
+		sel.gids = gids
+	}
+	if len(result) == 0 {
+		result = s.Select(nodes, config.GlobalConfig().SessNodes)
+		sel = selection{epoch: s.Epoch, gids: make([]string, 0, len(result))}
+		for _, n := range result {
+			sel.gids = append(sel.gids, n.GID)
+		}
+	}
+	if !ok {
+		// selections older than the previous epoch are never requested again
+		for k, old := range selections {
+			if old.epoch < CurrentEpoch()-1 {
+				delete(selections, k)
+			}
+		}
+	}
+	selections[s.Key] = sel
+	return result
+}
+
+// "Select" ranks the nodes by their hash with the session key and returns the first count.
+// The rank of a node only depends on the key and its GID, so a smaller count is always a subset of a larger one.
+// The selection changes as nodes join or leave, use Nodes for the node set of the whole epoch.
+func (s *Session) Select(nodes []node.Node, count int) []node.Node {
+	type ranked struct {
+		rank string
+		node node.Node
+	}
+	r := make([]ranked, 0, len(nodes))
+	for _, n := range nodes {
+		r = append(r, ranked{hash(s.Key + n.GID), n})
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i].rank < r[j].rank })
+	if count <= 0 || count > len(r) {
+		count = len(r)
+	}
+	result := make([]node.Node, 0, count)
+	for _, n := range r[:count] {
+		result = append(result, n.node)
+	}
+	return result
+}
+
+// "Contains" returns true if the node is within the session.
+func (s *Session) Contains(gid string) bool {
+	for _, g := range s.GIDs {
+		if g == gid {
+			return true
+		}
+	}
+	return false
+}
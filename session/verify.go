@@ -0,0 +1,73 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/util"
+)
+
+// "ErrNotInSession" is returned when a relay does not belong to a session of this node.
+var ErrNotInSession = errors.New("this node is not within the developer's session")
+
+var (
+	sessions    = make(map[string]*Session) // <Key><Session>
+	sessionLock sync.Mutex
+)
+
+// "Verify" checks with the dispatcher that the node is within the developer's session.
+// The previous epoch is accepted as well, so relays sent across an epoch boundary are served.
+func Verify(ctx context.Context, devID string, bc node.Blockchain, gid string) error {
+	epoch := CurrentEpoch()
+	for _, e := range []int64{epoch, epoch - 1} {
+		s, err := Fetch(ctx, devID, bc, e)
+		if err != nil {
+			return err
+		}
+		if s.Contains(gid) {
+			return nil
+		}
+	}
+	return ErrNotInSession
+}
+
+// "Fetch" returns the session from the dispatcher, caching it for the rest of its epoch.
+// The dispatcher's call carries the request id of the context.
+func Fetch(ctx context.Context, devID string, bc node.Blockchain, epoch int64) (*Session, error) {
+	key := Key(devID, bc, epoch)
+	sessionLock.Lock()
+	s, ok := sessions[key]
+	sessionLock.Unlock()
+	if ok {
+		return s, nil
+	}
+	u, err := util.URLProto(config.GlobalConfig().DisIP + ":" + config.GlobalConfig().DisRPort + "/v1/session")
+	if err != nil {
+		return nil, err
+	}
+	res, err := util.StructRPCReqContext(ctx, u, Request{DevID: devID, Blockchain: bc, Epoch: epoch}, util.POST)
+	if err != nil {
+		return nil, err
+	}
+	s = &Session{}
+	if err := json.Unmarshal([]byte(res), s); err != nil {
+		return nil, err
+	}
+	if s.Key != key {
+		return nil, errors.New("the dispatcher returned a session with a mismatched key")
+	}
+	sessionLock.Lock()
+	defer sessionLock.Unlock()
+	// sessions older than the previous epoch are never verified again
+	for k, old := range sessions {
+		if old.Epoch < CurrentEpoch()-1 {
+			delete(sessions, k)
+		}
+	}
+	sessions[key] = s
+	return s, nil
+}
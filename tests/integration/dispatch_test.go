@@ -22,12 +22,12 @@ func DummyNode() node.Node {
 
 func TestDB(t *testing.T) {
 	n := DummyNode()
-	_, err := db.DB().Add(n)
+	err := db.Store().Add(n)
 	if err != nil {
 		t.Log(assumptions)
 		t.Fatalf(err.Error())
 	}
-	_, err = db.DB().Remove(n)
+	err = db.Store().Remove(n)
 	if err != nil {
 		t.Log(assumptions)
 		t.Fatalf(err.Error())
@@ -1,7 +1,19 @@
 package integration
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/util"
+	"golang.org/x/crypto/ed25519"
 )
 
 const (
@@ -10,8 +22,44 @@ const (
 	whitelist  = "whitelist"
 )
 
+// "testKey" signs every request, so the node that registers can unregister.
+// The dispatcher under test must whitelist its public key (start it with -sfile fixtures/service_whitelist.json).
+var testKey = ed25519.NewKeyFromSeed(append([]byte("pocket-core integration tests"), 0, 0, 0))
+
+// "signedRequestFromFile" signs the node within the fixture for the action and posts it to the dispatcher.
+func signedRequestFromFile(action string) (string, error) {
+	dispatchU, err := util.URLProto(*dispatchU)
+	if err != nil {
+		return "", err
+	}
+	fp, err := filepath.Abs("fixtures" + _const.FILESEPARATOR + action + ".json")
+	if err != nil {
+		return "", err
+	}
+	b, err := ioutil.ReadFile(fp)
+	if err != nil {
+		return "", err
+	}
+	c := node.Claims{Action: action, Timestamp: time.Now().Unix()}
+	if err := json.Unmarshal(b, &c.Node); err != nil {
+		return "", err
+	}
+	prefix := strings.Split(c.Node.GID, ":")[0]
+	c.Node.GID = prefix + ":" + hex.EncodeToString(testKey.Public().(ed25519.PublicKey))
+	nonce, err := crypto.RandBytes(16)
+	if err != nil {
+		return "", err
+	}
+	c.Nonce = hex.EncodeToString(nonce)
+	reg := node.Registration{}
+	if err := reg.Sign(testKey, c); err != nil {
+		return "", err
+	}
+	return util.StructRPCReq(dispatchU+action, reg, util.POST)
+}
+
 func TestRegister(t *testing.T) {
-	resp, err := requestFromFile(register)
+	resp, err := signedRequestFromFile(register)
 	if err != nil {
 		t.Log(assumptions)
 		t.Fatalf(err.Error())
@@ -20,7 +68,7 @@ func TestRegister(t *testing.T) {
 }
 
 func TestUnRegister(t *testing.T) {
-	resp, err := requestFromFile(unregister)
+	resp, err := signedRequestFromFile(unregister)
 	if err != nil {
 		t.Log(assumptions)
 		t.Fatalf(err.Error())
@@ -29,7 +77,7 @@ func TestUnRegister(t *testing.T) {
 }
 
 func TestWhiteList(t *testing.T) {
-	resp, err := requestFromFile(whitelist)
+	resp, err := signedRequestFromFile(whitelist)
 	if err != nil {
 		t.Log(assumptions)
 		t.Fatalf(err.Error())
@@ -0,0 +1,91 @@
+package unit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/rpc/relay"
+)
+
+func TestRelayBatch(t *testing.T) {
+	w := httptest.NewRecorder()
+	relay.RelayBatch(w, httptest.NewRequest("POST", "/v1/relay/batch", strings.NewReader(`[]`)), nil)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected an empty batch to be rejected, got %d", w.Code)
+	}
+	// improperly formatted relays fail on their own, in the order of the batch
+	w = httptest.NewRecorder()
+	body := `[{"blockchain":"ETH","netid":"4"},{"blockchain":"BTC","devid":"DEV1","data":"{}"}]`
+	relay.RelayBatch(w, httptest.NewRequest("POST", "/v1/relay/batch", strings.NewReader(body)), nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected a batch response, got %d", w.Code)
+	}
+	results := []relay.BatchResult{}
+	if err := json.Unmarshal(w.Body.Bytes(), &results); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(results) != 2 || results[0].Code != http.StatusBadRequest || results[1].Code != http.StatusBadRequest {
+		t.Fatalf("unexpected batch results %v", results)
+	}
+}
+
+func TestRelayBatchOrder(t *testing.T) {
+	// later relays are answered first
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := struct {
+			ID int `json:"id"`
+		}{}
+		json.NewDecoder(r.Body).Decode(&req)
+		time.Sleep(time.Duration(10-req.ID) * 10 * time.Millisecond)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":"%d"}`, req.ID, req.ID)
+	}))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer hostChains(t, `[{"blockchain":{"name":"BATCH","netid":"1"},"host":"http://`+u.Hostname()+`","port":"`+u.Port()+`","medium":"rpc"}]`)()
+	node.WhiteListInit()
+	node.DWL().Add("BATCHDEV")
+	defer node.DWL().Remove("BATCHDEV")
+	relays := make([]map[string]string, 0)
+	for id := 1; id <= 9; id++ {
+		relays = append(relays, map[string]string{"blockchain": "BATCH", "netid": "1", "devid": "BATCHDEV",
+			"data": `{"jsonrpc":"2.0","id":` + strconv.Itoa(id) + `,"method":"eth_chainId"}`})
+	}
+	// a relay failing within the batch gets the status of a single relay
+	relays = append(relays, map[string]string{"blockchain": "UNHOSTED", "netid": "1", "devid": "BATCHDEV", "data": `{}`})
+	body, err := json.Marshal(relays)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	w := httptest.NewRecorder()
+	start := time.Now()
+	relay.RelayBatch(w, httptest.NewRequest("POST", "/v1/relay/batch", strings.NewReader(string(body))), nil)
+	if time.Since(start) > 300*time.Millisecond {
+		t.Fatalf("the relays of the batch were not executed concurrently")
+	}
+	results := []relay.BatchResult{}
+	if err := json.Unmarshal(w.Body.Bytes(), &results); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(results) != 10 {
+		t.Fatalf("unexpected batch results %v", results)
+	}
+	for i, r := range results[:9] {
+		if r.Code != http.StatusOK || !strings.Contains(r.Result, `"result":"`+strconv.Itoa(i+1)+`"`) {
+			t.Fatalf("result %d is out of order: %v", i, r)
+		}
+	}
+	if results[9].Code != http.StatusNotFound {
+		t.Fatalf("expected the unhosted chain's relay to fail with 404, got %v", results[9])
+	}
+}
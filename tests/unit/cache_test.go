@@ -0,0 +1,111 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/cache"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/service"
+)
+
+func TestLRUCache(t *testing.T) {
+	// room for two entries of a 100 byte value (keys and overhead included)
+	c := cache.New(2 * 200)
+	value := make([]byte, 100)
+	c.Set("a", value, time.Minute)
+	c.Set("b", value, time.Minute)
+	if _, ok := c.Get("a"); !ok {
+		t.Fatalf("expected a hit")
+	}
+	// "b" is now the least recently used
+	c.Set("c", value, time.Minute)
+	if _, ok := c.Get("b"); ok {
+		t.Fatalf("the least recently used entry was not evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatalf("a recently used entry was evicted")
+	}
+	c.Set("expired", value, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	if _, ok := c.Get("expired"); ok {
+		t.Fatalf("an expired entry was served")
+	}
+	// values over the cap aren't stored
+	c.Set("large", make([]byte, 1000), time.Minute)
+	if _, ok := c.Get("large"); ok {
+		t.Fatalf("a value over the memory cap was stored")
+	}
+	s := c.Stats()
+	if s.Hits != 2 || s.Misses != 3 || s.Evictions != 2 || s.Entries != 1 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+	if s.Bytes > s.MaxBytes {
+		t.Fatalf("the cache holds %d bytes over its cap", s.Bytes-s.MaxBytes)
+	}
+}
+
+func TestRelayCache(t *testing.T) {
+	calls := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		req := struct {
+			ID     json.RawMessage `json:"id"`
+			Params []interface{}   `json:"params"`
+		}{}
+		json.NewDecoder(r.Body).Decode(&req)
+		// a block that doesn't exist
+		if len(req.Params) > 0 && req.Params[0] == "0x1" {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":null}`, req.ID)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x1"}`, req.ID)
+	}))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer hostChains(t, `[{"blockchain":{"name":"CACHE","netid":"1"},"host":"http://`+u.Hostname()+`","port":"`+u.Port()+
+		`","medium":"rpc","cache":[{"method":"eth_chainId","ttl":60},{"method":"eth_getBlockByNumber","ttl":60}],"cachedepth":10}]`)()
+	node.WhiteListInit()
+	node.DWL().Add("CACHEDEV")
+	defer node.DWL().Remove("CACHEDEV")
+	relay := func(data string) string {
+		resp, err := service.RouteRelay(context.Background(), service.Relay{Blockchain: "CACHE", NetworkID: "1", DevID: "CACHEDEV", Data: data})
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		return resp.Body
+	}
+	relay(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`)
+	// the id of the request is answered, not the cached one
+	if body := relay(`{"jsonrpc":"2.0", "id":2, "method":"eth_chainId", "params":[]}`); !strings.Contains(body, `"id":2`) {
+		t.Fatalf("unexpected cached response %s", body)
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single upstream call, got %d", calls)
+	}
+	// methods without a rule are always relayed
+	relay(`{"jsonrpc":"2.0","id":3,"method":"eth_blockNumber","params":[]}`)
+	relay(`{"jsonrpc":"2.0","id":4,"method":"eth_blockNumber","params":[]}`)
+	if calls != 3 {
+		t.Fatalf("expected uncached methods to be relayed, got %d calls", calls)
+	}
+	// only blocks at least the cache depth below the head are cached, null results never are
+	node.SetSyncStatus(node.SyncStatus{Blockchain: node.Blockchain{Name: "CACHE", NetID: "1"}, Height: 100, Synced: true})
+	for _, block := range []string{`"0x5a"`, `"0x5b"`, `"0x1"`} {
+		relay(`{"jsonrpc":"2.0","id":5,"method":"eth_getBlockByNumber","params":[` + block + `,false]}`)
+		relay(`{"jsonrpc":"2.0","id":6,"method":"eth_getBlockByNumber","params":[` + block + `,false]}`)
+	}
+	if calls != 3+1+2+2 {
+		t.Fatalf("expected only the final block to be cached, got %d calls", calls)
+	}
+}
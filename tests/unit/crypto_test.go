@@ -0,0 +1,84 @@
+package unit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/crypto"
+)
+
+// a fixed math/rand seed must not reproduce any random bytes
+func TestRandBytesIgnoresSeed(t *testing.T) {
+	rand.Seed(1)
+	a, err := crypto.RandBytes(32)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	rand.Seed(1)
+	b, err := crypto.RandBytes(32)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if bytes.Equal(a, b) {
+		t.Fatalf("RandBytes returned the same bytes after reseeding math/rand")
+	}
+	// the bytes math/rand produces for the seed
+	rand.Seed(1)
+	seeded := make([]byte, 32)
+	rand.Read(seeded)
+	if bytes.Equal(a, seeded) || bytes.Equal(b, seeded) {
+		t.Fatalf("RandBytes returned the math/rand bytes of the seed")
+	}
+}
+
+// a fixed math/rand seed must not reproduce any key
+func TestGenerateKeyIgnoresSeed(t *testing.T) {
+	rand.Seed(1)
+	_, a, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	rand.Seed(1)
+	_, b, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if bytes.Equal(a, b) {
+		t.Fatalf("GenerateKey returned the same key after reseeding math/rand")
+	}
+}
+
+func TestLegacyGIDMigration(t *testing.T) {
+	dd := config.GlobalConfig().DD
+	config.Build()
+	gid := config.GlobalConfig().GID
+	fp := filepath.Join(dd, _const.GIDFILENAME)
+	legacy := "GID1:da39a3ee5e6b4b0d3255bfef95601890afd80709"
+	if err := ioutil.WriteFile(fp, []byte(legacy), 0644); err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer os.Remove(filepath.Join(dd, _const.LEGACYGIDFILENAME))
+	if g := config.GIDSetup(); g != gid {
+		t.Fatalf("the GID changed from %s to %s, expected the key derived GID", gid, g)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dd, _const.LEGACYGIDFILENAME))
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if string(b) != legacy {
+		t.Fatalf("the legacy gid file holds %s, expected %s", string(b), legacy)
+	}
+	b, err = ioutil.ReadFile(fp)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if string(b) != gid {
+		t.Fatalf("the gid file holds %s, expected %s", string(b), gid)
+	}
+}
@@ -1,8 +1,14 @@
 package unit
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/pokt-network/pocket-core/const"
 	"github.com/pokt-network/pocket-core/db"
 	"github.com/pokt-network/pocket-core/node"
 )
@@ -22,7 +28,7 @@ func DummyNode() node.Node {
 
 func TestPut(t *testing.T) {
 	d := db.DB()
-	_, err := d.Add(DummyNode())
+	err := d.Add(DummyNode())
 	if err != nil {
 		t.Fatalf(err.Error())
 	}
@@ -30,8 +36,113 @@ func TestPut(t *testing.T) {
 
 func TestRemove(t *testing.T) {
 	d := db.DB()
-	_, err := d.Remove(DummyNode())
+	err := d.Remove(DummyNode())
 	if err != nil {
 		t.Fatalf(err.Error())
 	}
 }
+
+func TestMemoryStore(t *testing.T) {
+	s := db.NewMemoryStore()
+	stop := make(chan struct{})
+	defer close(stop)
+	events, err := s.Watch(stop)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	n := DummyNode()
+	if err := s.Add(n); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if e := <-events; e.Type != db.PeerAdded || e.Node.GID != n.GID {
+		t.Fatalf("MemoryStore.Add(Node) did not produce an added event for the node")
+	}
+	nodes, err := s.List()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(nodes) != 1 {
+		t.Fatalf("MemoryStore.List() returned a result other than length of 1")
+	}
+	if err := s.Remove(n); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if e := <-events; e.Type != db.PeerRemoved {
+		t.Fatalf("MemoryStore.Remove(Node) did not produce a removed event")
+	}
+	if nodes, _ := s.List(); len(nodes) != 0 {
+		t.Fatalf("MemoryStore.Remove(Node) did not appear to remove the node")
+	}
+}
+
+func TestMemoryStoreSlowSubscriber(t *testing.T) {
+	s := db.NewMemoryStore()
+	stop := make(chan struct{})
+	defer close(stop)
+	events, err := s.Watch(stop)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	// a subscriber that stopped reading doesn't block the writers
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			n := DummyNode()
+			n.GID = "slow" + strconv.Itoa(i)
+			s.Add(n)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("a full subscriber blocked the writers")
+	}
+	// its channel is closed instead, so it starts over from a fresh listing
+	count := 0
+	for range events {
+		count++
+	}
+	if count == 100 {
+		t.Fatalf("expected the events past the subscriber's buffer to be dropped")
+	}
+}
+
+func TestBoltStoreRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peers")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, _const.DBFILENAME)
+	s, err := db.NewBoltStore(path)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	n := DummyNode()
+	removed := DummyNode()
+	removed.GID = "removed"
+	if err := s.Add(n); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if err := s.Add(removed); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if err := s.Remove(removed); err != nil {
+		t.Fatalf(err.Error())
+	}
+	s.Close()
+	// reopen the file as a restarted dispatcher would
+	s, err = db.NewBoltStore(path)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer s.Close()
+	nodes, err := s.List()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(nodes) != 1 || nodes[0].GID != n.GID {
+		t.Fatalf("BoltStore did not replay the registered node after reopening")
+	}
+}
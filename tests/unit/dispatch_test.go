@@ -0,0 +1,85 @@
+package unit
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/dispatch"
+	"github.com/pokt-network/pocket-core/node"
+)
+
+func dummyNodes(count int) []node.Node {
+	nodes := make([]node.Node, 0, count)
+	for i := 0; i < count; i++ {
+		n := dummyNode()
+		n.GID = "GID" + strconv.Itoa(i)
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+func dummyNodesByGID(gids []string) []node.Node {
+	nodes := make([]node.Node, 0, len(gids))
+	for _, gid := range gids {
+		n := dummyNode()
+		n.GID = gid
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+func TestStrategyCount(t *testing.T) {
+	nodes := dummyNodes(10)
+	for _, name := range _const.STRATEGIES {
+		result := dispatch.GetStrategy(name).Select("DEVID1", nodes[0].Blockchains[0], nodes, 3)
+		if len(result) != 3 {
+			t.Fatalf("Strategy " + name + " did not return the requested count of nodes")
+		}
+		seen := make(map[string]struct{})
+		for _, n := range result {
+			if _, ok := seen[n.GID]; ok {
+				t.Fatalf("Strategy " + name + " returned a node more than once")
+			}
+			seen[n.GID] = struct{}{}
+		}
+	}
+}
+
+func TestLeastServedForget(t *testing.T) {
+	nodes := dummyNodesByGID([]string{"SERVED1", "SERVED2"})
+	s := dispatch.GetStrategy(_const.STRATLEASTSERVED)
+	if first := s.Select("DEVID1", nodes[0].Blockchains[0], nodes, 1); first[0].GID != "SERVED1" {
+		t.Fatalf("expected the first never served node, got %s", first[0].GID)
+	}
+	// a forgotten node counts as never served again
+	dispatch.ForgetServed("SERVED1")
+	if again := s.Select("DEVID1", nodes[0].Blockchains[0], nodes, 1); again[0].GID != "SERVED1" {
+		t.Fatalf("expected the forgotten node to be served first, got %s", again[0].GID)
+	}
+	dispatch.ForgetServed("SERVED1")
+}
+
+func TestRoundRobinStrategy(t *testing.T) {
+	nodes := dummyNodes(4)
+	bc := node.Blockchain{Name: "roundrobin", NetID: "1"}
+	s := dispatch.GetStrategy(_const.STRATROUNDROBIN)
+	first := s.Select("DEVID1", bc, nodes, 2)
+	second := s.Select("DEVID1", bc, nodes, 2)
+	if first[0].GID == second[0].GID || first[1].GID == second[1].GID {
+		t.Fatalf("Round robin strategy served the same nodes twice in a row")
+	}
+}
+
+func TestHashStrategy(t *testing.T) {
+	nodes := dummyNodes(10)
+	bc := nodes[0].Blockchains[0]
+	s := dispatch.GetStrategy(_const.STRATHASH)
+	first := s.Select("DEVID1", bc, nodes, 3)
+	second := s.Select("DEVID1", bc, nodes, 3)
+	for i := range first {
+		if first[i].GID != second[i].GID {
+			t.Fatalf("Hash strategy did not serve the same nodes to the same developer")
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package unit
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/health"
+	"github.com/pokt-network/pocket-core/node"
+)
+
+func TestHealthCheck(t *testing.T) {
+	syncing := false
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if syncing {
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"syncing"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x10"}`)
+	}))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	hc := node.HostedChain{Blockchain: node.Blockchain{Name: "ETH", NetID: "4"}, Host: "http://" + u.Hostname(), Port: u.Port(), Medium: "rpc"}
+	height, err := health.Check(hc)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if height != 16 {
+		t.Fatalf("expected a height of 16, got %d", height)
+	}
+	syncing = true
+	if _, err := health.Check(hc); err == nil {
+		t.Fatalf("a json rpc error was considered healthy")
+	}
+	// the health is recorded per chain
+	if !node.SetChainHealth(hc.Blockchain, false) || node.ChainHealthy(hc.Blockchain) {
+		t.Fatalf("the chain was not marked unhealthy")
+	}
+	if node.SetChainHealth(hc.Blockchain, false) {
+		t.Fatalf("an unchanged health was reported as changed")
+	}
+	if !node.SetChainHealth(hc.Blockchain, true) || !node.ChainHealthy(hc.Blockchain) {
+		t.Fatalf("the chain did not recover")
+	}
+}
@@ -0,0 +1,57 @@
+package unit
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/crypto/keystore"
+)
+
+func TestKeystore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	ks := keystore.New(dir)
+	// light scrypt parameters to keep the test fast
+	ks.ScryptN = 1 << 4
+	k, err := ks.Generate("pass")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if _, err := ks.Key(k.Address, "wrong"); err != keystore.ErrDecrypt {
+		t.Fatalf("expected a decryption error with the wrong passphrase, got %v", err)
+	}
+	priv, err := ks.Export(k.Address, "pass")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	// import into a second keystore with another passphrase
+	other := keystore.New(dir + "/other")
+	other.ScryptN = 1 << 4
+	imported, err := other.Import(priv, "other")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if imported.Address != k.Address {
+		t.Fatalf("the imported key has address %s, expected %s", imported.Address, k.Address)
+	}
+	if _, err := other.Import(priv, "other"); err == nil {
+		t.Fatalf("a key was imported twice")
+	}
+	if _, err := ks.Generate("pass"); err != nil {
+		t.Fatalf(err.Error())
+	}
+	addrs, err := ks.List()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(addrs) != 2 {
+		t.Fatalf("expected 2 keys, got %v", addrs)
+	}
+	if _, err := ks.Key("../"+k.Address, "pass"); err == nil {
+		t.Fatalf("an invalid address was accepted")
+	}
+}
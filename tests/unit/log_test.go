@@ -1,7 +1,13 @@
 package unit
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/pokt-network/pocket-core/logs"
 )
@@ -10,4 +16,104 @@ func TestLogs(t *testing.T) {
 	if err := logs.NewLog("Unit test for the log functionality", logs.InfoLevel, logs.JSONLogFormat); err != nil {
 		t.Fatalf(err.Error())
 	}
+	// fatal and panic entries are logged without exiting or panicking
+	if err := logs.NewLog("Unit test for a fatal log", logs.FatalLevel, logs.JSONLogFormat); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if err := logs.NewLog("Unit test for a panic log", logs.PanicLevel, logs.JSONLogFormat); err != nil {
+		t.Fatalf(err.Error())
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logs")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.log")
+	f, err := logs.NewRotatingFile(path, logs.RotateConfig{MaxSize: 100, MaxBackups: 2})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer f.Close()
+	line := strings.Repeat("x", 39) + "\n"
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := f.Write([]byte(line)); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	// two lines fit per file, so 10 lines rotate 4 times, of which 2 files are kept
+	if rotated := f.Rotated(); len(rotated) != 2 {
+		t.Fatalf("expected 2 rotated files, got %v", rotated)
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if string(b) != line+line {
+		t.Fatalf("unexpected log file content %q", string(b))
+	}
+	// files are rotated after their interval regardless of their size
+	g, err := logs.NewRotatingFile(filepath.Join(dir, "interval.log"), logs.RotateConfig{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer g.Close()
+	g.Write([]byte(line))
+	time.Sleep(5 * time.Millisecond)
+	g.Write([]byte(line))
+	if rotated := g.Rotated(); len(rotated) != 1 {
+		t.Fatalf("expected the file to be rotated after its interval, got %v", rotated)
+	}
+}
+
+func TestRotatingFileRecovers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logs")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.log")
+	f, err := logs.NewRotatingFile(path, logs.RotateConfig{MaxSize: 50})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer f.Close()
+	line := strings.Repeat("x", 39) + "\n"
+	if _, err := f.Write([]byte(line)); err != nil {
+		t.Fatalf(err.Error())
+	}
+	// the rotation fails while the directory is gone
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if _, err := f.Write([]byte(line)); err == nil {
+		t.Fatalf("expected the rotation to fail without the log directory")
+	}
+	// the log file is reopened once the directory is back
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if _, err := f.Write([]byte(line)); err != nil {
+		t.Fatalf("expected the log file to be reopened after a failed rotation: %s", err.Error())
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if string(b) != line {
+		t.Fatalf("unexpected log file content %q", string(b))
+	}
+	// a closed file is not reopened
+	f.Close()
+	if _, err := f.Write([]byte(line)); err != os.ErrClosed {
+		t.Fatalf("expected a closed file to stay closed, got %v", err)
+	}
 }
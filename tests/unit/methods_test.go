@@ -0,0 +1,57 @@
+package unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/service"
+)
+
+func TestMethodAllowed(t *testing.T) {
+	hc := node.HostedChain{}
+	if !hc.MethodAllowed("admin_peers") {
+		t.Fatalf("expected every method to be allowed without lists")
+	}
+	hc = node.HostedChain{Allow: []string{"eth_*", "net_version"}, Deny: []string{"eth_sign*"}}
+	for method, allowed := range map[string]bool{
+		"eth_blockNumber":        true,
+		"net_version":            true,
+		"net_peerCount":          false,
+		"eth_signTransaction":    false,
+		"personal_unlockAccount": false,
+	} {
+		if hc.MethodAllowed(method) != allowed {
+			t.Fatalf("expected %s allowed to be %v", method, allowed)
+		}
+	}
+}
+
+func TestRelayDeniedMethod(t *testing.T) {
+	// the upstream is never reached, rejected relays don't need one
+	defer hostChains(t, `[{"blockchain":{"name":"DENY","netid":"1"},"host":"http://127.0.0.1","port":"1","medium":"rpc","deny":["admin_*","debug_*"]}]`)()
+	node.WhiteListInit()
+	node.DWL().Add("DENYDEV")
+	defer node.DWL().Remove("DENYDEV")
+	for _, data := range []string{
+		`{"jsonrpc":"2.0","id":1,"method":"admin_peers","params":[]}`,
+		// a denied method can't hide within a batch
+		`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"debug_traceTransaction"}]`,
+		`not json`,
+		// the key is matched exactly, once, as some hosted chains are case sensitive or use the first duplicate
+		`{"jsonrpc":"2.0","id":1,"method":"admin_addPeer","METHOD":"eth_call"}`,
+		`{"jsonrpc":"2.0","id":1,"method":"admin_addPeer","method":"eth_call"}`,
+		`{"jsonrpc":"2.0","id":1,"Method":"admin_addPeer"}`,
+	} {
+		_, err := service.RouteRelay(context.Background(), service.Relay{Blockchain: "DENY", NetworkID: "1", DevID: "DENYDEV", Data: data})
+		if _, ok := err.(*service.MethodError); !ok {
+			t.Fatalf("expected %s to be rejected, got %v", data, err)
+		}
+	}
+	// the http method of a rest relay is ignored by the rpc medium, it doesn't skip the check
+	relay := service.Relay{Blockchain: "DENY", NetworkID: "1", DevID: "DENYDEV", Method: "GET", Data: `{"jsonrpc":"2.0","id":1,"method":"admin_peers"}`}
+	_, err := service.RouteRelay(context.Background(), relay)
+	if _, ok := err.(*service.MethodError); !ok {
+		t.Fatalf("expected the denied method to be rejected with a http method set, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/metrics"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/rpc/relay"
+	"github.com/pokt-network/pocket-core/service"
+)
+
+func TestMetricsExposition(t *testing.T) {
+	c := metrics.NewCounter("test_requests_total", "The test requests.", "chain")
+	c.Inc("ETH")
+	c.Add(2, `quoted "chain"`)
+	h := metrics.NewHistogram("test_duration_seconds", "The test durations.", []float64{.1, 1}, "chain")
+	h.Observe(.05, "ETH")
+	h.Observe(.5, "ETH")
+	h.Observe(5, "ETH")
+	b := &bytes.Buffer{}
+	if err := metrics.Write(b); err != nil {
+		t.Fatalf(err.Error())
+	}
+	for _, line := range []string{
+		"# TYPE test_requests_total counter",
+		`test_requests_total{chain="ETH"} 1`,
+		`test_requests_total{chain="quoted \"chain\""} 2`,
+		"# TYPE test_duration_seconds histogram",
+		`test_duration_seconds_bucket{chain="ETH",le="0.1"} 1`,
+		`test_duration_seconds_bucket{chain="ETH",le="1"} 2`,
+		`test_duration_seconds_bucket{chain="ETH",le="+Inf"} 3`,
+		`test_duration_seconds_sum{chain="ETH"} 5.55`,
+		`test_duration_seconds_count{chain="ETH"} 3`,
+	} {
+		if !strings.Contains(b.String(), line+"\n") {
+			t.Fatalf("expected the line %s in\n%s", line, b.String())
+		}
+	}
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	n := dummyNode()
+	node.DispatchPeers().Add(n)
+	defer node.DispatchPeers().Delete(n)
+	w := httptest.NewRecorder()
+	relay.Metrics(w, httptest.NewRequest("GET", "/metrics", nil), nil)
+	if !strings.HasPrefix(w.Header().Get("Content-Type"), "text/plain") {
+		t.Fatalf("unexpected content type %s", w.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(w.Body.String(), `pocket_dispatch_peers{blockchain="ethereum",netid="1"} `) {
+		t.Fatalf("the dispatch peers are missing from\n%s", w.Body.String())
+	}
+}
+
+func TestMetricsUnhostedChain(t *testing.T) {
+	node.WhiteListInit()
+	node.DWL().Add("METRICSDEV")
+	defer node.DWL().Remove("METRICSDEV")
+	// made up chains are rejected before they become series
+	_, err := service.RouteRelay(context.Background(), service.Relay{Blockchain: "MADEUP", NetworkID: "1", DevID: "METRICSDEV", Data: `{}`})
+	if err != service.ErrUnhostedChain {
+		t.Fatalf("expected the unhosted chain to be rejected, got %v", err)
+	}
+	b := &bytes.Buffer{}
+	if err := metrics.Write(b); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if strings.Contains(b.String(), "MADEUP") {
+		t.Fatalf("the unhosted chain became a series in\n%s", b.String())
+	}
+}
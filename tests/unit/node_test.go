@@ -2,6 +2,7 @@ package unit
 
 import (
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
@@ -60,6 +61,23 @@ func copyFile(src, dst string) error {
 	return out.Close()
 }
 
+// "hostChains" replaces the hosted chains with the chains.json, restore hosts the fixture's chains again.
+func hostChains(t *testing.T, chains string) (restore func()) {
+	fixture, err := filepath.Abs("fixtures" + _const.FILESEPARATOR + "chains.json")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	f := filepath.Join(config.GlobalConfig().DD, "test_chains.json")
+	defer os.Remove(f)
+	if err := ioutil.WriteFile(f, []byte(chains), 0600); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if _, err := node.ReloadChains(f); err != nil {
+		t.Fatalf(err.Error())
+	}
+	return func() { node.ReloadChains(fixture) }
+}
+
 func dummyNode() node.Node {
 	chains := []node.Blockchain{{Name: "ethereum", NetID: "1"}}
 	n := node.Node{
@@ -148,3 +166,73 @@ func TestWhiteList(t *testing.T) {
 		t.Fatalf("After calling WhiteList.Remove(ID) the ID still exists")
 	}
 }
+
+func TestReloadChains(t *testing.T) {
+	fixture, err := filepath.Abs("fixtures" + _const.FILESEPARATOR + "chains.json")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if _, err := node.ReloadChains(fixture); err != nil {
+		t.Fatalf(err.Error())
+	}
+	// restore the fixture's chains for the other tests
+	defer node.ReloadChains(fixture)
+	f := filepath.Join(config.GlobalConfig().DD, "reload_chains.json")
+	defer os.Remove(f)
+	reload := func(data string) bool {
+		if err := ioutil.WriteFile(f, []byte(data), 0600); err != nil {
+			t.Fatalf(err.Error())
+		}
+		changed, err := node.ReloadChains(f)
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		return changed
+	}
+	eth := node.Blockchain{Name: "ethereum", NetID: "1"}
+	btc := node.Blockchain{Name: "bitcoin", NetID: "1"}
+	if !reload(`[{"blockchain":{"name":"ethereum","netid":"1"},"port":"8545","medium":"rpc"},{"blockchain":{"name":"bitcoin","netid":"1"},"port":"8333","medium":"rpc"}]`) {
+		t.Fatalf("adding a chain was not reported as a change")
+	}
+	if node.ChainToHosted(btc).Port != "8333" {
+		t.Fatalf("the added chain is not hosted")
+	}
+	// reconfiguring a chain doesn't change what is advertised
+	if reload(`[{"blockchain":{"name":"ethereum","netid":"1"},"port":"8546","medium":"rpc"},{"blockchain":{"name":"bitcoin","netid":"1"},"port":"8333","medium":"rpc"}]`) {
+		t.Fatalf("reconfiguring a chain was reported as a change")
+	}
+	if node.ChainToHosted(eth).Port != "8546" {
+		t.Fatalf("the chain was not reconfigured")
+	}
+	if !reload(`[{"blockchain":{"name":"ethereum","netid":"1"},"port":"8546","medium":"rpc"}]`) {
+		t.Fatalf("removing a chain was not reported as a change")
+	}
+	if len(node.ChainsSlice()) != 1 {
+		t.Fatalf("the removed chain is still hosted")
+	}
+	// added chains are probed before they are advertised
+	node.SetProbe(func(bc node.Blockchain) bool { return node.SetChainHealth(bc, false) })
+	defer node.SetProbe(nil)
+	if !reload(`[{"blockchain":{"name":"ethereum","netid":"1"},"port":"8546","medium":"rpc"},{"blockchain":{"name":"bitcoin","netid":"1"},"port":"8333","medium":"rpc"}]`) {
+		t.Fatalf("adding a chain was not reported as a change")
+	}
+	if node.ChainHealthy(btc) || !node.ChainHealthy(eth) {
+		t.Fatalf("expected only the added chain to be probed")
+	}
+	node.SetProbe(nil)
+	reload(`[{"blockchain":{"name":"ethereum","netid":"1"},"port":"8546","medium":"rpc"}]`)
+	// a malformed file keeps the current chains
+	if err := ioutil.WriteFile(f, []byte(`[{`), 0600); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if _, err := node.ReloadChains(f); err == nil || len(node.ChainsSlice()) != 1 {
+		t.Fatalf("a malformed file was applied")
+	}
+	// so does a file with a malformed method pattern
+	if err := ioutil.WriteFile(f, []byte(`[{"blockchain":{"name":"ethereum","netid":"1"},"port":"8546","medium":"rpc","deny":["admin_["]}]`), 0600); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if _, err := node.ReloadChains(f); err == nil || len(node.ChainToHosted(eth).Deny) != 0 {
+		t.Fatalf("a malformed method pattern was applied")
+	}
+}
@@ -0,0 +1,178 @@
+package unit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/plugin"
+	_ "github.com/pokt-network/pocket-core/plugin/rest"
+	_ "github.com/pokt-network/pocket-core/plugin/rpc"
+	_ "github.com/pokt-network/pocket-core/plugin/tcp"
+	_ "github.com/pokt-network/pocket-core/plugin/ws"
+)
+
+type echoPlugin struct{}
+
+func (echoPlugin) Execute(ctx context.Context, relay plugin.Relay, hc node.HostedChain) (plugin.Response, error) {
+	return plugin.Response{Body: hc.Medium + ":" + relay.Data}, nil
+}
+
+func TestPluginRegistry(t *testing.T) {
+	for _, m := range []string{"", "rpc", "rest", "tcp", "ws"} {
+		if _, err := plugin.Get(m); err != nil {
+			t.Fatalf(err.Error())
+		}
+	}
+	if _, err := plugin.Get("carrierpigeon"); err == nil {
+		t.Fatalf("an unregistered medium was found")
+	}
+	plugin.Register("echo", echoPlugin{})
+	resp, err := plugin.Execute(context.Background(), plugin.Relay{Data: "data"}, node.HostedChain{Medium: "echo"})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if resp.Body != "echo:data" || resp.Code != 200 {
+		t.Fatalf("the relay was not routed through the medium's plugin, got %v", resp)
+	}
+}
+
+func TestRESTPlugin(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+		fmt.Fprint(w, r.Method+" "+r.URL.RequestURI()+" "+r.Header.Get("X-Test"))
+	}))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	hc := node.HostedChain{Host: u.Hostname(), Port: u.Port(), Path: "/token", Medium: "rest"}
+	relay := plugin.Relay{Path: "../blocks/latest", Query: "?format=json", Headers: map[string]string{"X-Test": "yes"}}
+	resp, err := plugin.Execute(context.Background(), relay, hc)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if resp.Code != http.StatusTeapot {
+		t.Fatalf("expected the upstream status code %d, got %d", http.StatusTeapot, resp.Code)
+	}
+	expected := "GET /token/blocks/latest?format=json yes"
+	if resp.Body != expected {
+		t.Fatalf("got %s, expected %s", resp.Body, expected)
+	}
+}
+
+func TestTCPPlugin(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer l.Close()
+	// a chain that sends a notification before answering every request
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func(c net.Conn) {
+				defer c.Close()
+				r := bufio.NewReader(c)
+				for {
+					line, err := r.ReadBytes('\n')
+					if err != nil {
+						return
+					}
+					req := struct {
+						ID json.RawMessage `json:"id"`
+					}{}
+					json.Unmarshal(line, &req)
+					fmt.Fprint(c, `{"method":"blockchain.headers.subscribe","params":[]}`+"\n")
+					if len(req.ID) == 0 {
+						fmt.Fprint(c, `[{"id":1,"result":"ok"}]`+"\n") // a batch
+						continue
+					}
+					fmt.Fprint(c, `{"id":`+string(req.ID)+`,"result":"ok"}`+"\n")
+				}
+			}(c)
+		}
+	}()
+	host, port, err := net.SplitHostPort(l.Addr().String())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	hc := node.HostedChain{Host: host, Port: port, Medium: "tcp", Timeout: 1000}
+	for _, id := range []string{"1", `"two"`} {
+		relay := plugin.Relay{Data: "{\n\"id\": " + id + ", \"method\": \"server.version\"}"}
+		resp, err := plugin.Execute(context.Background(), relay, hc)
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		expected := `{"id":` + id + `,"result":"ok"}`
+		if resp.Body != expected {
+			t.Fatalf("got %s, expected %s", resp.Body, expected)
+		}
+	}
+	// a batch has no id, the notification before its response is skipped
+	resp, err := plugin.Execute(context.Background(), plugin.Relay{Data: `[{"id":1,"method":"server.version"}]`}, hc)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if resp.Body != `[{"id":1,"result":"ok"}]` {
+		t.Fatalf("got %s, expected the batch response", resp.Body)
+	}
+}
+
+func TestTCPPluginNoResend(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer l.Close()
+	// a chain that answers the first request only
+	var received int32
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func(c net.Conn) {
+				defer c.Close()
+				r := bufio.NewReader(c)
+				for {
+					if _, err := r.ReadBytes('\n'); err != nil {
+						return
+					}
+					if atomic.AddInt32(&received, 1) == 1 {
+						fmt.Fprint(c, `{"id":1,"result":"ok"}`+"\n")
+					}
+				}
+			}(c)
+		}
+	}()
+	host, port, err := net.SplitHostPort(l.Addr().String())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	hc := node.HostedChain{Host: host, Port: port, Medium: "tcp", Timeout: 200}
+	relay := plugin.Relay{Data: `{"id":1,"method":"blockchain.transaction.broadcast"}`}
+	if _, err := plugin.Execute(context.Background(), relay, hc); err != nil {
+		t.Fatalf(err.Error())
+	}
+	// the request reached the chain on the pooled connection, a timeout must not send it again
+	if _, err := plugin.Execute(context.Background(), relay, hc); err == nil {
+		t.Fatalf("expected the unanswered request to time out")
+	}
+	if n := atomic.LoadInt32(&received); n != 2 {
+		t.Fatalf("expected the request to be sent once, the chain received %d requests", n)
+	}
+}
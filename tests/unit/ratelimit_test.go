@@ -0,0 +1,85 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/ratelimit"
+	"github.com/pokt-network/pocket-core/service"
+)
+
+func TestRateLimit(t *testing.T) {
+	eth := ratelimit.Chain{Blockchain: "ethereum", NetID: "1"}
+	btc := ratelimit.Chain{Blockchain: "bitcoin", NetID: "1"}
+	ratelimit.SetLimits([]ratelimit.Limit{
+		{DevID: "RATEDEV", Blockchain: "ethereum", Rate: 0.001, Burst: 2},
+	})
+	defer ratelimit.SetLimits(nil)
+	for i := 0; i < 2; i++ {
+		if ok, _ := ratelimit.Allow("RATEDEV", eth); !ok {
+			t.Fatalf("Allow() rejected a request within the burst")
+		}
+	}
+	ok, retry := ratelimit.Allow("RATEDEV", eth)
+	if ok || retry <= 0 {
+		t.Fatalf("Allow() did not reject a request over the burst with a retry after")
+	}
+	if ok, _ := ratelimit.Allow("RATEDEV", btc); !ok {
+		t.Fatalf("Allow() rejected a request for a chain without limits")
+	}
+	if ok, _ := ratelimit.Allow("OTHERDEV", eth); !ok {
+		t.Fatalf("Allow() rejected a request of a developer without limits")
+	}
+}
+
+func TestQuota(t *testing.T) {
+	ratelimit.SetLimits([]ratelimit.Limit{{DevID: "*", Daily: 1}})
+	defer ratelimit.SetLimits(nil)
+	if ok, _ := ratelimit.Allow("QUOTADEV"); !ok {
+		t.Fatalf("Allow() rejected a request within the daily quota")
+	}
+	ok, retry := ratelimit.Allow("QUOTADEV")
+	if ok || retry <= 0 || retry > 24*time.Hour {
+		t.Fatalf("Allow() did not reject a request over the daily quota until the next day")
+	}
+	// the quota of any developer is counted per developer
+	if ok, _ := ratelimit.Allow("OTHERQUOTADEV"); !ok {
+		t.Fatalf("Allow() shared the daily quota between developers")
+	}
+}
+
+func TestRateLimitRoutedOnly(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer hostChains(t, `[{"blockchain":{"name":"LIMITED","netid":"1"},"host":"http://`+u.Hostname()+`","port":"`+u.Port()+
+		`","medium":"rpc","deny":["admin_*"]}]`)()
+	node.WhiteListInit()
+	node.DWL().Add("LIMITEDDEV")
+	defer node.DWL().Remove("LIMITEDDEV")
+	ratelimit.SetLimits([]ratelimit.Limit{{DevID: "*", Daily: 1}})
+	defer ratelimit.SetLimits(nil)
+	relay := service.Relay{Blockchain: "LIMITED", NetworkID: "1", DevID: "LIMITEDDEV", Data: `{"jsonrpc":"2.0","id":1,"method":"admin_peers"}`}
+	// rejected relays don't count against the quota
+	if _, err := service.RouteRelay(context.Background(), relay); err == nil {
+		t.Fatalf("expected the denied method to be rejected")
+	}
+	relay.Data = `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`
+	if _, err := service.RouteRelay(context.Background(), relay); err != nil {
+		t.Fatalf(err.Error())
+	}
+	_, err = service.RouteRelay(context.Background(), relay)
+	if e, ok := err.(*ratelimit.Error); !ok || e.RetryAfter <= 0 {
+		t.Fatalf("expected the daily quota to be exceeded, got %v", err)
+	}
+}
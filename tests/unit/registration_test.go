@@ -0,0 +1,99 @@
+package unit
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/pokt-network/pocket-core/node"
+	"golang.org/x/crypto/ed25519"
+)
+
+func claims(t *testing.T, key ed25519.PrivateKey, action string) node.Claims {
+	nonce, err := crypto.RandBytes(16)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	return node.Claims{
+		Node:      node.Node{GID: "GID1:" + hex.EncodeToString(key.Public().(ed25519.PublicKey)), IP: "127.0.0.1"},
+		Action:    action,
+		Nonce:     hex.EncodeToString(nonce),
+		Timestamp: time.Now().Unix(),
+	}
+}
+
+func signedRegistration(t *testing.T, key ed25519.PrivateKey, action string) node.Registration {
+	reg := node.Registration{}
+	if err := reg.Sign(key, claims(t, key, action)); err != nil {
+		t.Fatalf(err.Error())
+	}
+	return reg
+}
+
+func TestRegistration(t *testing.T) {
+	pub, key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	node.WhiteListInit()
+	// a key outside of the whitelist is rejected, whatever the prefix of its GID
+	node.SWL().Add("GID1")
+	defer node.SWL().Remove("GID1")
+	reg := signedRegistration(t, key, node.RegisterAction)
+	if _, err := reg.Verify(node.RegisterAction); err == nil {
+		t.Fatalf("a registration signed by a key outside of the whitelist was accepted")
+	}
+	node.SWL().Add(hex.EncodeToString(pub))
+	defer node.SWL().Remove(hex.EncodeToString(pub))
+	reg = signedRegistration(t, key, node.RegisterAction)
+	if _, err := reg.Verify(node.UnRegisterAction); err == nil {
+		t.Fatalf("a register signature was accepted for unregister")
+	}
+	n, err := reg.Verify(node.RegisterAction)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if n.IP != "127.0.0.1" {
+		t.Fatalf("expected the signed node, got %v", n)
+	}
+	if _, err := reg.Verify(node.RegisterAction); err == nil {
+		t.Fatalf("a replayed registration was accepted")
+	}
+	// tampered payload
+	reg = signedRegistration(t, key, node.RegisterAction)
+	reg.Payload = bytes.Replace(reg.Payload, []byte("127.0.0.1"), []byte("10.0.0.1"), 1)
+	if _, err := reg.Verify(node.RegisterAction); err == nil {
+		t.Fatalf("a tampered registration was accepted")
+	}
+	// signed by another key
+	_, other, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	reg = node.Registration{}
+	if err := reg.Sign(other, claims(t, key, node.RegisterAction)); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if _, err := reg.Verify(node.RegisterAction); err == nil {
+		t.Fatalf("a registration signed by another key was accepted")
+	}
+	// stale
+	c := claims(t, key, node.RegisterAction)
+	c.Timestamp -= 10 * 60
+	reg = node.Registration{}
+	if err := reg.Sign(key, c); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if _, err := reg.Verify(node.RegisterAction); err == nil {
+		t.Fatalf("a stale registration was accepted")
+	}
+	// fields unknown to this version are covered by the signature and ignored
+	reg = signedRegistration(t, key, node.RegisterAction)
+	reg.Payload = append(reg.Payload[:len(reg.Payload)-1], []byte(`,"future":true}`)...)
+	reg.Signature = crypto.Sign(key, reg.Payload)
+	if _, err := reg.Verify(node.RegisterAction); err != nil {
+		t.Fatalf(err.Error())
+	}
+}
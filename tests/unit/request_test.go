@@ -0,0 +1,65 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/rpc/shared"
+	"github.com/pokt-network/pocket-core/service"
+)
+
+func TestRequestID(t *testing.T) {
+	var seen string
+	router := shared.Router(shared.Routes{shared.Route{Name: "Test", Method: "GET", Path: "/test",
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+			seen = logs.RequestID(r.Context())
+		}}})
+	// the caller's id is kept
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set(_const.REQUESTIDHEADER, "dispatch-1")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if seen != "dispatch-1" || rec.Header().Get(_const.REQUESTIDHEADER) != "dispatch-1" {
+		t.Fatalf("expected the caller's request id, got %q and %q", seen, rec.Header().Get(_const.REQUESTIDHEADER))
+	}
+	// a missing or invalid id is replaced
+	req = httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set(_const.REQUESTIDHEADER, "not valid\n")
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if seen == "" || seen == "not valid\n" || rec.Header().Get(_const.REQUESTIDHEADER) != seen {
+		t.Fatalf("expected a generated request id, got %q", seen)
+	}
+}
+
+func TestRequestIDUpstream(t *testing.T) {
+	var seen string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r.Header.Get(_const.REQUESTIDHEADER)
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer hostChains(t, `[{"blockchain":{"name":"TRACED","netid":"1"},"host":"http://`+u.Hostname()+`","port":"`+u.Port()+
+		`","medium":"rpc"}]`)()
+	node.WhiteListInit()
+	node.DWL().Add("TRACEDDEV")
+	defer node.DWL().Remove("TRACEDDEV")
+	relay := service.Relay{Blockchain: "TRACED", NetworkID: "1", DevID: "TRACEDDEV", Data: `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`}
+	if _, err := service.RouteRelay(logs.WithRequestID(context.Background(), "relay-1"), relay); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if seen != "relay-1" {
+		t.Fatalf("expected the hosted chain to receive the request id, got %q", seen)
+	}
+}
@@ -0,0 +1,77 @@
+package unit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/session"
+)
+
+func TestSessionKey(t *testing.T) {
+	bc := dummyNode().Blockchains[0]
+	epoch := session.CurrentEpoch()
+	if session.Key("DEVID1", bc, epoch) != session.Key("DEVID1", bc, epoch) {
+		t.Fatalf("Session keys of the same developer, blockchain and epoch are not equal")
+	}
+	if session.Key("DEVID1", bc, epoch) == session.Key("DEVID1", bc, epoch+1) {
+		t.Fatalf("Session keys of different epochs are equal")
+	}
+}
+
+func TestSessionNodes(t *testing.T) {
+	nodes := dummyNodes(10)
+	bc := nodes[0].Blockchains[0]
+	s := session.NewSession("DEVID1", bc, session.CurrentEpoch(), nodes)
+	if len(s.GIDs) != config.GlobalConfig().SessNodes {
+		t.Fatalf("NewSession() did not select the configured number of session nodes")
+	}
+	// the same node set is derived for the whole epoch, regardless of the registration order
+	reversed := make([]string, 0)
+	for i := len(nodes) - 1; i >= 0; i-- {
+		reversed = append(reversed, nodes[i].GID)
+	}
+	again := session.NewSession("DEVID1", bc, s.Epoch, dummyNodesByGID(reversed))
+	for i, gid := range s.GIDs {
+		if again.GIDs[i] != gid {
+			t.Fatalf("NewSession() did not derive the same nodes for the same session")
+		}
+	}
+	// a smaller selection is a subset of the session
+	for _, n := range s.Select(nodes, 2) {
+		if !s.Contains(n.GID) {
+			t.Fatalf("Session.Select() of a smaller count returned a node outside of the session")
+		}
+	}
+}
+
+func TestSessionSticky(t *testing.T) {
+	nodes := dummyNodes(10)
+	bc := nodes[0].Blockchains[0]
+	epoch := session.CurrentEpoch()
+	s := session.NewSession("STICKYDEV", bc, epoch, nodes[:5])
+	// a node joining within the epoch does not displace the session nodes, even with a better rank
+	joined := false
+	for _, n := range s.Select(nodes, len(s.GIDs)) {
+		joined = joined || !s.Contains(n.GID)
+	}
+	if !joined {
+		t.Fatalf("expected a joining node to rank before a session node")
+	}
+	again := session.NewSession("STICKYDEV", bc, epoch, nodes)
+	if !reflect.DeepEqual(again.GIDs, s.GIDs) {
+		t.Fatalf("NewSession() changed the session nodes after a node joined: %v, expected %v", again.GIDs, s.GIDs)
+	}
+	// a dead node is dropped, the others stay
+	alive := make([]node.Node, 0)
+	for _, n := range nodes {
+		if n.GID != s.GIDs[0] {
+			alive = append(alive, n)
+		}
+	}
+	again = session.NewSession("STICKYDEV", bc, epoch, alive)
+	if !reflect.DeepEqual(again.GIDs, s.GIDs[1:]) {
+		t.Fatalf("NewSession() did not only drop the dead node: %v, expected %v", again.GIDs, s.GIDs[1:])
+	}
+}
@@ -0,0 +1,34 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/dispatch"
+	"github.com/pokt-network/pocket-core/node"
+)
+
+func TestInSync(t *testing.T) {
+	bc := node.Blockchain{Name: "ETH", NetID: "1"}
+	lag := uint64(config.GlobalConfig().SyncLag)
+	nodes := []node.Node{{GID: "synced"}, {GID: "lagging"}, {GID: "syncing"}, {GID: "unreported"}}
+	dispatch.ObserveSync("synced", []node.SyncStatus{{Blockchain: bc, Height: 100 + lag, Synced: true}})
+	dispatch.ObserveSync("lagging", []node.SyncStatus{{Blockchain: bc, Height: 99, Synced: true}})
+	dispatch.ObserveSync("syncing", []node.SyncStatus{{Blockchain: bc, Height: 100 + lag, Reference: 1000, Synced: false}})
+	for _, n := range nodes {
+		defer dispatch.ForgetSync(n.GID)
+	}
+	result := dispatch.InSync(bc, nodes)
+	if len(result) != 2 || result[0].GID != "synced" || result[1].GID != "unreported" {
+		t.Fatalf("expected only the synced and unreported nodes, got %v", result)
+	}
+	// a single node reporting a height far ahead doesn't exclude the others
+	dispatch.ObserveSync("lagging", []node.SyncStatus{{Blockchain: bc, Height: 1 << 63, Synced: true}})
+	if result := dispatch.InSync(bc, nodes); len(result) != 3 || result[0].GID != "synced" {
+		t.Fatalf("expected the outlier to be ignored, got %v", result)
+	}
+	// the heights are compared per chain
+	if result := dispatch.InSync(node.Blockchain{Name: "BTC", NetID: "1"}, nodes); len(result) != len(nodes) {
+		t.Fatalf("expected every node on a chain without reports, got %v", result)
+	}
+}
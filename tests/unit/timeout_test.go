@@ -0,0 +1,48 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/service"
+)
+
+func TestRelayTimeout(t *testing.T) {
+	release := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer s.Close()
+	defer close(release)
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer hostChains(t, `[{"blockchain":{"name":"SLOW","netid":"1"},"host":"http://`+u.Hostname()+`","port":"`+u.Port()+
+		`","medium":"rpc","timeout":200}]`)()
+	node.WhiteListInit()
+	node.DWL().Add("SLOWDEV")
+	defer node.DWL().Remove("SLOWDEV")
+	relay := service.Relay{Blockchain: "SLOW", NetworkID: "1", DevID: "SLOWDEV", Data: `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`}
+	start := time.Now()
+	if _, err := service.RouteRelay(context.Background(), relay); err != service.ErrRelayTimeout {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Fatalf("the relay outlived the chain's timeout")
+	}
+	// the developer going away cancels the upstream call
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	if _, err := service.RouteRelay(ctx, relay); err != service.ErrRelayCanceled {
+		t.Fatalf("expected a cancellation, got %v", err)
+	}
+}
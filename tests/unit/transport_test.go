@@ -0,0 +1,100 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/plugin/rpc"
+	"github.com/pokt-network/pocket-core/util"
+)
+
+// "rpcUpstream" is a hosted chain answering every json rpc request, counting the scheme detection pings.
+func rpcUpstream(pings *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			atomic.AddInt32(pings, 1)
+			return
+		}
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	}))
+}
+
+func TestURLProtoCache(t *testing.T) {
+	var pings int32
+	s := rpcUpstream(&pings)
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, util.HTTP)
+	for i := 0; i < 3; i++ {
+		u, err := util.URLProto(host + "/v1")
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		if u != s.URL+"/v1" {
+			t.Fatalf("expected the http scheme, got %s", u)
+		}
+	}
+	if n := atomic.LoadInt32(&pings); n != 1 {
+		t.Fatalf("expected the scheme to be detected once, got %d pings", n)
+	}
+	util.ForgetScheme(host)
+	if _, err := util.URLProto(host); err != nil || atomic.LoadInt32(&pings) != 2 {
+		t.Fatalf("a forgotten scheme was not detected again")
+	}
+}
+
+// "BenchmarkRelayPooled" relays over the shared transport with the cached scheme of the hosted chain.
+func BenchmarkRelayPooled(b *testing.B) {
+	var pings int32
+	s := rpcUpstream(&pings)
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, util.HTTP)
+	data := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		raw, err := util.URLProto(host)
+		if err != nil {
+			b.Fatal(err)
+		}
+		u, _ := url.Parse(raw)
+		if _, _, err := rpc.ExecuteRequest(context.Background(), data, u); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// "BenchmarkRelayUnpooled" relays as before the shared transport: the scheme is detected and a new connection is made per relay.
+func BenchmarkRelayUnpooled(b *testing.B) {
+	var pings int32
+	s := rpcUpstream(&pings)
+	defer s.Close()
+	host := strings.TrimPrefix(s.URL, util.HTTP)
+	data := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		util.ForgetScheme(host)
+		raw, err := util.URLProto(host)
+		if err != nil {
+			b.Fatal(err)
+		}
+		req, err := http.NewRequest("POST", raw, bytes.NewBuffer(data))
+		if err != nil {
+			b.Fatal(err)
+		}
+		req.Close = true
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := (&http.Client{}).Do(req)
+		if err != nil {
+			b.Fatal(err)
+		}
+		ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+	}
+}
@@ -0,0 +1,80 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/node"
+	"github.com/pokt-network/pocket-core/service"
+)
+
+func TestUpstreamEndpoints(t *testing.T) {
+	hc := node.HostedChain{Host: "single", Port: "1"}
+	if eps := hc.Endpoints(); len(eps) != 1 || eps[0].Host != "single" {
+		t.Fatalf("expected the chain's own host as its only upstream, got %v", eps)
+	}
+	hc = node.HostedChain{Upstreams: []node.Upstream{
+		{Host: "backup", Port: "1", Priority: 1},
+		{Host: "primary-a", Port: "1", Weight: 3},
+		{Host: "primary-b", Port: "1", Weight: 1},
+	}}
+	eps := hc.Endpoints()
+	if len(eps) != 3 || eps[2].Host != "backup" {
+		t.Fatalf("expected the lower priority upstreams first, got %v", eps)
+	}
+	// repeated failures take the upstream out of rotation
+	for i := 0; i < _const.UPSTREAMFAILURES; i++ {
+		node.ReportUpstream(node.Upstream{Host: "primary-a", Port: "1"}, false)
+		node.ReportUpstream(node.Upstream{Host: "primary-b", Port: "1"}, false)
+	}
+	defer node.ReportUpstream(node.Upstream{Host: "primary-a", Port: "1"}, true)
+	defer node.ReportUpstream(node.Upstream{Host: "primary-b", Port: "1"}, true)
+	eps = hc.Endpoints()
+	if eps[0].Host != "backup" {
+		t.Fatalf("expected the failing upstreams to be tried last, got %v", eps)
+	}
+	if at := hc.At(eps[0]); at.Host != "backup" || len(at.Upstreams) != 0 {
+		t.Fatalf("expected the hosted chain to point at the upstream, got %v", at)
+	}
+}
+
+func TestUpstreamFailover(t *testing.T) {
+	var failed, served int
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		failed++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	defer up.Close()
+	d, err := url.Parse(down.URL)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	u, err := url.Parse(up.URL)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	defer hostChains(t, `[{"blockchain":{"name":"FAILOVER","netid":"1"},"medium":"rpc","upstreams":[`+
+		`{"host":"http://`+d.Hostname()+`","port":"`+d.Port()+`"},`+
+		`{"host":"http://`+u.Hostname()+`","port":"`+u.Port()+`","priority":1}]}]`)()
+	defer node.ReportUpstream(node.Upstream{Host: "http://" + d.Hostname(), Port: d.Port()}, true)
+	node.WhiteListInit()
+	node.DWL().Add("FAILOVERDEV")
+	defer node.DWL().Remove("FAILOVERDEV")
+	relay := service.Relay{Blockchain: "FAILOVER", NetworkID: "1", DevID: "FAILOVERDEV", Data: `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`}
+	resp, err := service.RouteRelay(context.Background(), relay)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if resp.Code != http.StatusOK || failed != 1 || served != 1 {
+		t.Fatalf("expected the 503 to be retried on the next upstream, got %d after %d failed and %d served", resp.Code, failed, served)
+	}
+}
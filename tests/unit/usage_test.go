@@ -0,0 +1,37 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/usage"
+)
+
+func TestUsage(t *testing.T) {
+	key := usage.Key{DevID: "USAGEDEV", Blockchain: "ethereum", NetID: "1", GID: "GID1"}
+	usage.Meter(usage.Record{Key: key, RequestBytes: 10, ResponseBytes: 100, Latency: 20 * time.Millisecond})
+	usage.Meter(usage.Record{Key: key, RequestBytes: 10, Latency: 40 * time.Millisecond, Err: errors.New("upstream error")})
+	r := usage.Usage(usage.Query{DevID: key.DevID})
+	if r.Total.Relays != 2 || r.Total.Errors != 1 {
+		t.Fatalf("Usage() did not count the metered relays and errors")
+	}
+	if r.Total.RequestBytes != 20 || r.Total.ResponseBytes != 100 || r.Total.LatencyMS != 60 {
+		t.Fatalf("Usage() did not sum the metered bytes and latency")
+	}
+	if r := usage.Usage(usage.Query{DevID: key.DevID, Blockchain: "bitcoin"}); r.Total.Relays != 0 {
+		t.Fatalf("Usage() counted relays of a different blockchain")
+	}
+	if r := usage.Usage(usage.Query{DevID: key.DevID, To: time.Now().Add(-2 * time.Hour).Unix()}); r.Total.Relays != 0 {
+		t.Fatalf("Usage() counted relays outside of the time window")
+	}
+	// notifications are billed even in an hour without relays
+	notified := usage.Key{DevID: "NOTIFIEDDEV", Blockchain: "ethereum", NetID: "1", GID: "GID1"}
+	usage.MeterResponse(notified, 50)
+	if r := usage.Usage(usage.Query{DevID: notified.DevID}); len(r.Buckets) != 1 || r.Total.ResponseBytes != 50 {
+		t.Fatalf("Usage() skipped the notification bytes of an hour without relays")
+	}
+	if err := usage.Flush(); err != nil {
+		t.Fatalf(err.Error())
+	}
+}
@@ -0,0 +1,136 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pokt-network/pocket-core/plugin/ws"
+)
+
+// "wsChain" is a hosted chain that sends a notification before answering every json rpc request.
+func wsChain(t *testing.T) *httptest.Server {
+	up := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := up.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			req := struct {
+				ID     json.RawMessage `json:"id"`
+				Method string          `json:"method"`
+			}{}
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","method":"eth_subscription","params":{}}`))
+			conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":`+string(req.ID)+`,"result":"`+req.Method+`"}`))
+		}
+	}))
+}
+
+func TestWSExecuteRequest(t *testing.T) {
+	s := wsChain(t)
+	defer s.Close()
+	su, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	u, err := ws.URL(su.Hostname(), su.Port(), "")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	// the second request reuses the pooled connection
+	for _, id := range []string{"1", "2"} {
+		resp, err := ws.ExecuteRequest(context.Background(), []byte(`{"jsonrpc":"2.0","id":`+id+`,"method":"eth_blockNumber"}`), u)
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		expected := `{"jsonrpc":"2.0","id":` + id + `,"result":"eth_blockNumber"}`
+		if resp != expected {
+			t.Fatalf("got %s, expected %s", resp, expected)
+		}
+	}
+}
+
+// "wsURL" returns the websocket url of the test server.
+func wsURL(t *testing.T, s *httptest.Server) *url.URL {
+	su, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	u, err := ws.URL(su.Hostname(), su.Port(), "")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	return u
+}
+
+func TestWSExecuteRequestNoResend(t *testing.T) {
+	up := websocket.Upgrader{}
+	// a chain that answers the first request only
+	var received int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := up.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+			if atomic.AddInt32(&received, 1) == 1 {
+				conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"result":"ok"}`))
+			}
+		}
+	}))
+	defer s.Close()
+	u := wsURL(t, s)
+	req := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_sendRawTransaction"}`)
+	if _, err := ws.ExecuteRequest(context.Background(), req, u); err != nil {
+		t.Fatalf(err.Error())
+	}
+	// the request reached the chain on the pooled connection, a timeout must not send it again
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if _, err := ws.ExecuteRequest(ctx, req, u); err == nil {
+		t.Fatalf("expected the unanswered request to time out")
+	}
+	if n := atomic.LoadInt32(&received); n != 2 {
+		t.Fatalf("expected the request to be sent once, the chain received %d requests", n)
+	}
+}
+
+func TestWSExecuteRequestRedial(t *testing.T) {
+	up := websocket.Upgrader{}
+	// a chain that closes every connection after answering one request
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := up.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"result":"ok"}`))
+	}))
+	defer s.Close()
+	u := wsURL(t, s)
+	req := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`)
+	// the second request finds the pooled connection closed and is sent on a new one
+	for i := 0; i < 2; i++ {
+		if _, err := ws.ExecuteRequest(context.Background(), req, u); err != nil {
+			t.Fatalf(err.Error())
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package usage
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/logs"
+	"github.com/pokt-network/pocket-core/node"
+)
+
+// "usageFile" returns the path of the usage counters within the data directory.
+func usageFile() string {
+	return config.GlobalConfig().DD + _const.FILESEPARATOR + _const.USAGEFILENAME
+}
+
+// "Load" reads the persisted usage counters from the data directory.
+func Load() error {
+	b, err := ioutil.ReadFile(usageFile())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var entries []entry
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		m.add(e.Hour, e.Key, e.Counters)
+	}
+	m.prune()
+	return nil
+}
+
+// "Flush" writes the usage counters to the data directory.
+func Flush() error {
+	m.prune()
+	m.Lock()
+	entries := make([]entry, 0)
+	for hour, b := range m.buckets {
+		for k, c := range b {
+			entries = append(entries, entry{Hour: hour, Key: k, Counters: *c})
+		}
+	}
+	m.Unlock()
+	b, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	// write then rename, so a crash never leaves a partial file
+	tmp := usageFile() + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, usageFile())
+}
+
+// "flushUsage" writes the usage counters to the data directory every x time.
+func flushUsage() {
+	for {
+		time.Sleep(_const.USAGEFLUSH * time.Second)
+		if err := Flush(); err != nil {
+			logs.NewLog("unable to write the usage file: "+err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		}
+	}
+}
+
+// "Start" loads the usage counters and persists them periodically and at shutdown.
+func Start() {
+	if err := Load(); err != nil {
+		logs.NewLog("unable to read the usage file: "+err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+	}
+	node.OnExit(func() {
+		if err := Flush(); err != nil {
+			logs.NewLog("unable to write the usage file: "+err.Error(), logs.ErrorLevel, logs.JSONLogFormat)
+		}
+	})
+	go flushUsage()
+}
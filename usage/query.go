@@ -0,0 +1,70 @@
+package usage
+
+import (
+	"sort"
+	"time"
+)
+
+// "Query" filters the usage by key and time window, empty fields match everything.
+type Query struct {
+	DevID      string `json:"devid"`
+	Blockchain string `json:"blockchain"`
+	NetID      string `json:"netid"`
+	GID        string `json:"gid"`
+	From       int64  `json:"from"` // unix seconds, inclusive (0 is the last 24 hours)
+	To         int64  `json:"to"`   // unix seconds, exclusive (0 is now)
+}
+
+// "Bucket" is the usage of one hour.
+type Bucket struct {
+	Hour int64 `json:"hour"`
+	Counters
+}
+
+// "Report" is the result of a usage query.
+type Report struct {
+	Query
+	Total   Counters `json:"total"`
+	Buckets []Bucket `json:"buckets"`
+}
+
+// "matches" returns true if the key passes the query's filters.
+func (q Query) matches(k Key) bool {
+	return (q.DevID == "" || q.DevID == k.DevID) &&
+		(q.Blockchain == "" || q.Blockchain == k.Blockchain) &&
+		(q.NetID == "" || q.NetID == k.NetID) &&
+		(q.GID == "" || q.GID == k.GID)
+}
+
+// "Usage" aggregates the counters that match the query, by hour.
+func Usage(q Query) Report {
+	if q.To == 0 {
+		q.To = time.Now().Unix()
+	}
+	if q.From == 0 {
+		q.From = q.To - 24*3600
+	}
+	r := Report{Query: q, Buckets: make([]Bucket, 0)}
+	m.Lock()
+	defer m.Unlock()
+	// a bucket is within the window if the hour overlaps it
+	for hour, b := range m.buckets {
+		if hour+3600 <= q.From || hour >= q.To {
+			continue
+		}
+		bucket := Bucket{Hour: hour}
+		for k, c := range b {
+			if q.matches(k) {
+				bucket.add(*c)
+			}
+		}
+		// notifications of a subscription are metered in hours without relays
+		if bucket.Counters == (Counters{}) {
+			continue
+		}
+		r.Total.add(bucket.Counters)
+		r.Buckets = append(r.Buckets, bucket)
+	}
+	sort.Slice(r.Buckets, func(i, j int) bool { return r.Buckets[i].Hour < r.Buckets[j].Hour })
+	return r
+}
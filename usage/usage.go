@@ -0,0 +1,104 @@
+// This package is for relay metering and per-developer usage accounting.
+package usage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/const"
+)
+
+// "Counters" holds the accumulated usage of relays.
+type Counters struct {
+	Relays        uint64 `json:"relays"`
+	Errors        uint64 `json:"errors"`
+	RequestBytes  uint64 `json:"requestbytes"`
+	ResponseBytes uint64 `json:"responsebytes"`
+	LatencyMS     uint64 `json:"latencyms"` // the sum of every relay's latency
+}
+
+// "add" accumulates other into the counters.
+func (c *Counters) add(other Counters) {
+	c.Relays += other.Relays
+	c.Errors += other.Errors
+	c.RequestBytes += other.RequestBytes
+	c.ResponseBytes += other.ResponseBytes
+	c.LatencyMS += other.LatencyMS
+}
+
+// "Key" identifies who the usage belongs to.
+type Key struct {
+	DevID      string `json:"devid"`
+	Blockchain string `json:"blockchain"`
+	NetID      string `json:"netid"`
+	GID        string `json:"gid"` // the service node that executed the relay
+}
+
+// "Record" is the usage of a single relay.
+type Record struct {
+	Key
+	RequestBytes  int
+	ResponseBytes int
+	Latency       time.Duration
+	Err           error
+}
+
+// "entry" is an hourly counter as persisted in the data directory.
+type entry struct {
+	Hour int64 `json:"hour"` // unix seconds of the start of the hour
+	Key
+	Counters
+}
+
+type meter struct {
+	buckets map[int64]map[Key]*Counters // <Hour><Key><Counters>
+	sync.Mutex
+}
+
+var m = &meter{buckets: make(map[int64]map[Key]*Counters)}
+
+// "hourOf" truncates a time to the start of its hour in unix seconds.
+func hourOf(t time.Time) int64 {
+	return t.Unix() - t.Unix()%3600
+}
+
+// "Meter" adds the relay's usage to the current hour.
+func Meter(r Record) {
+	c := Counters{Relays: 1, RequestBytes: uint64(r.RequestBytes), ResponseBytes: uint64(r.ResponseBytes),
+		LatencyMS: uint64(r.Latency / time.Millisecond)}
+	if r.Err != nil {
+		c.Errors = 1
+	}
+	m.add(hourOf(time.Now()), r.Key, c)
+}
+
+// "MeterResponse" adds response bytes that don't belong to a relay (e.g. subscription notifications) to the current hour.
+func MeterResponse(k Key, bytes int) {
+	m.add(hourOf(time.Now()), k, Counters{ResponseBytes: uint64(bytes)})
+}
+
+func (m *meter) add(hour int64, k Key, c Counters) {
+	m.Lock()
+	defer m.Unlock()
+	b, ok := m.buckets[hour]
+	if !ok {
+		b = make(map[Key]*Counters)
+		m.buckets[hour] = b
+	}
+	if _, ok := b[k]; !ok {
+		b[k] = &Counters{}
+	}
+	b[k].add(c)
+}
+
+// "prune" removes the buckets older than the retention period.
+func (m *meter) prune() {
+	m.Lock()
+	defer m.Unlock()
+	oldest := hourOf(time.Now()) - _const.USAGERETENTION*3600
+	for hour := range m.buckets {
+		if hour < oldest {
+			delete(m.buckets, hour)
+		}
+	}
+}
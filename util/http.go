@@ -2,10 +2,14 @@ package util
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"net/http"
+
+	"github.com/pokt-network/pocket-core/const"
+	"github.com/pokt-network/pocket-core/logs"
 )
 
 type Method int
@@ -23,7 +27,6 @@ func (m Method) String() string {
 // "RPCRequ" executes an RPC request
 func RPCRequ(url string, data []byte, m Method) (string, error) {
 	req, err := http.NewRequest(m.String(), url, bytes.NewBuffer(data))
-	req.Close = true
 	if err != nil {
 		return "", errors.New("Cannot convert struct to json " + err.Error())
 	}
@@ -32,6 +35,11 @@ func RPCRequ(url string, data []byte, m Method) (string, error) {
 
 // "StructRPCReq" sends an RPC request and returns the response
 func StructRPCReq(url string, data interface{}, m Method) (string, error) {
+	return StructRPCReqContext(context.Background(), url, data, m)
+}
+
+// "StructRPCReqContext" sends an RPC request bound by the context, forwarding its request id, and returns the response
+func StructRPCReqContext(ctx context.Context, url string, data interface{}, m Method) (string, error) {
 	// convert structure to json
 	j, err := json.Marshal(data)
 	// handle error
@@ -40,23 +48,27 @@ func StructRPCReq(url string, data interface{}, m Method) (string, error) {
 	}
 	// create new post request
 	req, err := http.NewRequest(m.String(), url, bytes.NewBuffer(j))
-	req.Close = true
 	// hanlde error
 	if err != nil {
 		return "", errors.New("Cannot create request " + err.Error())
 	}
-	return rpcRequ(url, req)
+	return rpcRequ(url, req.WithContext(ctx))
 }
 
 func rpcRequ(url string, req *http.Request) (string, error) {
 	// setup header for json data
 	req.Header.Set("Content-Type", "application/json")
-	// setup http client
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	if id := logs.RequestID(req.Context()); id != "" {
+		req.Header.Set(_const.REQUESTIDHEADER, id)
+	}
+	// the shared transport keeps the connection to the node alive
+	resp, err := PeerClient().Do(req)
 	if err != nil {
+		ForgetScheme(url)
 		return "", errors.New("Unable to do request " + err.Error())
 	}
+	// closing the body returns the connection to the shared transport
+	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		body, _ := ioutil.ReadAll(resp.Body)
 		return "", errors.New(string(body))
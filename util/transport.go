@@ -0,0 +1,85 @@
+package util
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/config"
+	"github.com/pokt-network/pocket-core/const"
+)
+
+var (
+	transport     *http.Transport
+	transportOnce sync.Once
+	schemes       = make(map[string]scheme) // <host:port><detected scheme>
+	schemeMux     sync.Mutex
+)
+
+// "scheme" is the detected protocol of a host.
+type scheme struct {
+	proto   string // HTTP or HTTPS
+	expires time.Time
+}
+
+// "Transport" is the shared http transport, which keeps alive connections to upstreams, the dispatcher and peers.
+func Transport() *http.Transport {
+	transportOnce.Do(func() {
+		transport = &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			DialContext:           (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+			MaxIdleConns:          0, // limited per host
+			MaxIdleConnsPerHost:   config.GlobalConfig().MaxIdleConns,
+			IdleConnTimeout:       _const.IDLECONNTIMEOUT * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		}
+	})
+	return transport
+}
+
+// "Client" returns a client of the shared transport with the timeout (0 for none, e.g. requests bound by a context).
+func Client(timeout time.Duration) *http.Client {
+	return &http.Client{Transport: Transport(), Timeout: timeout}
+}
+
+// "PeerClient" returns a client of the shared transport for requests between nodes.
+func PeerClient() *http.Client {
+	return Client(time.Duration(config.GlobalConfig().PeerTimeout) * time.Millisecond)
+}
+
+// "hostOf" returns the host:port of a url without a scheme.
+func hostOf(query string) string {
+	if i := strings.IndexAny(query, "/?"); i >= 0 {
+		return query[:i]
+	}
+	return query
+}
+
+// "cachedScheme" returns the unexpired scheme detected for the host.
+func cachedScheme(host string) (string, bool) {
+	schemeMux.Lock()
+	defer schemeMux.Unlock()
+	s, ok := schemes[host]
+	if !ok || time.Now().After(s.expires) {
+		return "", false
+	}
+	return s.proto, true
+}
+
+// "cacheScheme" records the scheme detected for the host.
+func cacheScheme(host, proto string) {
+	schemeMux.Lock()
+	defer schemeMux.Unlock()
+	schemes[host] = scheme{proto: proto, expires: time.Now().Add(_const.SCHEMETTL * time.Second)}
+}
+
+// "ForgetScheme" drops the scheme detected for the host of the url, e.g. after a request to it failed.
+func ForgetScheme(query string) {
+	query = strings.TrimPrefix(strings.TrimPrefix(query, HTTPS), HTTP)
+	schemeMux.Lock()
+	defer schemeMux.Unlock()
+	delete(schemes, hostOf(strings.TrimLeft(query, "/")))
+}
@@ -13,24 +13,35 @@ const (
 	HTTP  = "http://"
 )
 
+// "URLProto" prefixes the url with the scheme of its host, https if it answers and http otherwise.
+// The detected scheme is cached per host, so only the first call (per SCHEMETTL) pings the host.
 func URLProto(query string) (string, error) {
+	// already has a protocol
+	if strings.Contains(query, "://") {
+		return query, nil
+	}
 	if strings.Contains(query, "//") {
 		query = strings.TrimLeft(query, "//")
 	}
+	host := hostOf(query)
+	if proto, ok := cachedScheme(host); ok {
+		return proto + query, nil
+	}
 	_, err := Ping(HTTPS + query)
 	if err != nil {
 		_, err := Ping(HTTP + query)
 		if err != nil {
 			return "", errors.New("unreachable site error: " + err.Error())
 		}
+		cacheScheme(host, HTTP)
 		return HTTP + query, nil
 	}
+	cacheScheme(host, HTTPS)
 	return HTTPS + query, nil
 }
 
 func Ping(url string) (int, error) {
-	client := http.Client{}
-	client.Timeout = time.Duration(config.GlobalConfig().RequestTimeout) * time.Millisecond
+	client := Client(time.Duration(config.GlobalConfig().RequestTimeout) * time.Millisecond)
 	req, err := http.NewRequest("HEAD", url, nil)
 	if err != nil {
 		return 0, err